@@ -5,22 +5,28 @@ import (
 	"crypto/rand"
 	"errors"
 	"flag"
+	"fmt"
 	"net/http"
 	"os"
 	"os/signal"
 	"path"
+	"slices"
 	"strings"
 	"sync"
 	"syscall"
+	"time"
 
 	"github.com/garethgeorge/backrest/gen/go/v1/v1connect"
 	"github.com/garethgeorge/backrest/internal/api"
 	"github.com/garethgeorge/backrest/internal/auth"
 	"github.com/garethgeorge/backrest/internal/config"
+	"github.com/garethgeorge/backrest/internal/metrics"
 	"github.com/garethgeorge/backrest/internal/oplog"
 	"github.com/garethgeorge/backrest/internal/orchestrator"
 	"github.com/garethgeorge/backrest/internal/resticinstaller"
 	"github.com/garethgeorge/backrest/internal/rotatinglog"
+	"github.com/garethgeorge/backrest/internal/selfbackup"
+	"github.com/garethgeorge/backrest/pkg/restic"
 	"github.com/garethgeorge/backrest/webui"
 	"github.com/mattn/go-colorable"
 	"go.etcd.io/bbolt"
@@ -31,10 +37,35 @@ import (
 )
 
 var InstallDepsOnly = flag.Bool("install-deps-only", false, "install dependencies and exit")
+var OplogStore = flag.String("oplog-store", "bbolt", "storage of the operation log in the data directory, bbolt or sqlite. The sqlite store can be queried with SQL tools but is only available in builds with cgo enabled, the release builds are built without cgo. The stores don't share data")
+var CheckOplogIntegrity = flag.Bool("check-oplog-integrity", false, "verify every page of the bbolt operation log on startup and rebuild its indices if it's damaged. This slows startup for large logs, without it startup only compares the number of operations with the size of the time index, which still reads most of the log")
+
+var (
+	ExportOplog           = flag.String("export-oplog", "", "export the operation log as newline delimited protojson to the given file, or - for stdout, and exit")
+	ExportOplogRepo       = flag.String("export-oplog-repo", "", "only export operations of the given repo")
+	ExportOplogPlan       = flag.String("export-oplog-plan", "", "only export operations of the given plan")
+	ExportOplogSince      = flag.String("export-oplog-since", "", "only export operations started at or after the given RFC3339 time")
+	ExportOplogUntil      = flag.String("export-oplog-until", "", "only export operations started before the given RFC3339 time")
+	ExportOplogLogs       = flag.Bool("export-oplog-logs", false, "embed the logs referenced by the exported operations")
+	ImportOplog           = flag.String("import-oplog", "", "import an operation log export from the given file, or - for stdin, and exit")
+	ImportOplogRelinkLogs = flag.Bool("import-oplog-relink-logs", true, "store the logs embedded in the export and re-link the imported operations to them")
+)
+
+var (
+	RestoreSelfBackupRepo     = flag.String("restore-self-backup-repo", "", "rebuild the data dir and config from a self backup in the repo at the given URI and exit, backrest must not be running. The repo password and credentials are read from the environment e.g. RESTIC_PASSWORD")
+	RestoreSelfBackupSnapshot = flag.String("restore-self-backup-snapshot", "latest", "the self backup snapshot to restore")
+)
 
 func main() {
 	flag.Parse()
 
+	if *ExportOplog != "" || *ImportOplog != "" {
+		if err := transferOplog(); err != nil {
+			zap.S().Fatalf("Error transferring operation log: %v", err)
+		}
+		return
+	}
+
 	resticPath, err := resticinstaller.FindOrInstallResticBinary()
 	if err != nil {
 		zap.S().Fatalf("Error finding or installing restic: %v", err)
@@ -45,6 +76,13 @@ func main() {
 		return
 	}
 
+	if *RestoreSelfBackupRepo != "" {
+		if err := restoreSelfBackup(resticPath); err != nil {
+			zap.S().Fatalf("Error restoring self backup: %v", err)
+		}
+		return
+	}
+
 	ctx, cancel := context.WithCancel(context.Background())
 	go onterm(cancel)
 
@@ -61,16 +99,25 @@ func main() {
 	var wg sync.WaitGroup
 
 	// Create / load the operation log
-	oplogFile := path.Join(config.DataDir(), "oplog.boltdb")
-	oplog, err := oplog.NewOpLog(oplogFile)
+	oplogFile, log, err := openOplog()
 	if err != nil {
+		if errors.Is(err, oplog.ErrSqliteUnsupported) {
+			zap.S().Fatalf("Error creating oplog: %v, use -oplog-store=bbolt or a build with cgo enabled", err)
+		}
 		if !errors.Is(err, bbolt.ErrTimeout) {
 			zap.S().Fatalf("Timeout while waiting to open database, is the database open elsewhere?")
 		}
 		zap.S().Warnf("Operation log may be corrupted, if errors recur delete the file %q and restart. Your backups stored in your repos are safe.", oplogFile)
 		zap.S().Fatalf("Error creating oplog : %v", err)
 	}
-	defer oplog.Close()
+	defer log.Close()
+
+	// Report whether the clients streaming operation events keep up with the log.
+	wg.Add(1)
+	go func() {
+		oplog.ReportSubscriberStats(ctx, log, 10*time.Minute)
+		wg.Done()
+	}()
 
 	// Create rotating log storage
 	logStore := rotatinglog.NewRotatingLog(path.Join(config.DataDir(), "rotatinglogs"), 30) // 30 days of logs
@@ -79,7 +126,7 @@ func main() {
 	}
 
 	// Create orchestrator and start task loop.
-	orchestrator, err := orchestrator.NewOrchestrator(resticPath, cfg, oplog, logStore)
+	orchestrator, err := orchestrator.NewOrchestrator(resticPath, cfg, log, logStore)
 	if err != nil {
 		zap.S().Fatalf("Error creating orchestrator: %v", err)
 	}
@@ -90,12 +137,26 @@ func main() {
 		wg.Done()
 	}()
 
+	// Create the metrics rollups and keep them up to date with the operation log.
+	metricsStore, err := metrics.NewStore(path.Join(config.DataDir(), "metrics.boltdb"), log)
+	if err != nil {
+		zap.S().Fatalf("Error creating metrics store: %v", err)
+	}
+	defer metricsStore.Close()
+
+	wg.Add(1)
+	go func() {
+		metricsStore.Run(ctx)
+		wg.Done()
+	}()
+
 	// Create and serve the HTTP gateway
 	apiBackrestHandler := api.NewBackrestHandler(
 		configStore,
 		orchestrator,
-		oplog,
+		log,
 		logStore,
+		metricsStore,
 	)
 
 	apiAuthenticationHandler := api.NewAuthenticationHandler(authenticator)
@@ -146,6 +207,152 @@ func createConfigProvider() config.ConfigStore {
 	}
 }
 
+// transferOplog runs the export or import requested by the oplog flags against the operation log in the data directory.
+func transferOplog() error {
+	_, log, err := openOplog()
+	if err != nil {
+		return fmt.Errorf("open oplog, is backrest running?: %w", err)
+	}
+	defer log.Close()
+	logStore := rotatinglog.NewRotatingLog(path.Join(config.DataDir(), "rotatinglogs"), 30)
+
+	if *ImportOplog != "" {
+		in := os.Stdin
+		if *ImportOplog != "-" {
+			if in, err = os.Open(*ImportOplog); err != nil {
+				return err
+			}
+			defer in.Close()
+		}
+		if !*ImportOplogRelinkLogs {
+			logStore = nil
+		}
+		count, err := oplog.ImportFrom(log, in, logStore)
+		if err != nil {
+			return fmt.Errorf("import, imported %d operations before the failure: %w", count, err)
+		}
+		zap.S().Infof("Imported %d operations from %q", count, *ImportOplog)
+		return nil
+	}
+
+	query := oplog.Query{RepoId: *ExportOplogRepo, PlanId: *ExportOplogPlan}
+	if *ExportOplogSince != "" {
+		since, err := time.Parse(time.RFC3339, *ExportOplogSince)
+		if err != nil {
+			return fmt.Errorf("parse -export-oplog-since: %w", err)
+		}
+		query.StartTimeMs = since.UnixMilli()
+	}
+	if *ExportOplogUntil != "" {
+		until, err := time.Parse(time.RFC3339, *ExportOplogUntil)
+		if err != nil {
+			return fmt.Errorf("parse -export-oplog-until: %w", err)
+		}
+		query.EndTimeMs = until.UnixMilli()
+	}
+	if !*ExportOplogLogs {
+		logStore = nil
+	}
+
+	if *ExportOplog == "-" {
+		// the development logger writes to stdout, so nothing is logged to keep the export clean.
+		if _, err := oplog.ExportTo(os.Stdout, log, query, logStore); err != nil {
+			return fmt.Errorf("export: %w", err)
+		}
+		return nil
+	}
+
+	out, err := os.Create(*ExportOplog)
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+	count, err := oplog.ExportTo(out, log, query, logStore)
+	if err != nil {
+		return fmt.Errorf("export: %w", err)
+	}
+	if err := out.Sync(); err != nil {
+		return err
+	}
+	zap.S().Infof("Exported %d operations to %q", count, *ExportOplog)
+	return nil
+}
+
+// restoreSelfBackup restores a self backup snapshot to a temporary dir in the data dir and installs it over the current state.
+func restoreSelfBackup(resticPath string) error {
+	ctx := context.Background()
+	repo := restic.NewRepo(resticPath, *RestoreSelfBackupRepo, restic.WithEnviron())
+
+	snapshots, err := repo.Snapshots(ctx, restic.WithFlags("--tag", "plan:"+config.PlanForSelfBackup))
+	if err != nil {
+		return fmt.Errorf("list self backup snapshots: %w", err)
+	}
+	if len(snapshots) == 0 {
+		return errors.New("no self backup snapshots found in repo")
+	}
+	snapshot := snapshots[len(snapshots)-1] // restic lists snapshots oldest first.
+	if *RestoreSelfBackupSnapshot != "latest" {
+		idx := slices.IndexFunc(snapshots, func(s *restic.Snapshot) bool {
+			return strings.HasPrefix(s.Id, *RestoreSelfBackupSnapshot)
+		})
+		if idx == -1 {
+			return fmt.Errorf("self backup snapshot %q not found", *RestoreSelfBackupSnapshot)
+		}
+		snapshot = snapshots[idx]
+	}
+	if len(snapshot.Paths) != 1 {
+		return fmt.Errorf("snapshot %v is not a self backup, want 1 path got %d", snapshot.Id, len(snapshot.Paths))
+	}
+
+	// restore next to the data dir so that installing doesn't copy across file systems more than once.
+	if err := os.MkdirAll(config.DataDir(), 0700); err != nil {
+		return fmt.Errorf("create data dir: %w", err)
+	}
+	tmpDir, err := os.MkdirTemp(config.DataDir(), "restore-self-backup-")
+	if err != nil {
+		return fmt.Errorf("create restore dir: %w", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	zap.S().Infof("Restoring self backup snapshot %v taken at %v", snapshot.Id, time.UnixMilli(snapshot.UnixTimeMs()))
+	if _, err := repo.Restore(ctx, snapshot.Id, nil, restic.WithFlags("--target", tmpDir)); err != nil {
+		return fmt.Errorf("restore snapshot %v: %w", snapshot.Id, err)
+	}
+	if err := selfbackup.Install(path.Join(tmpDir, snapshot.Paths[0]), config.DataDir(), config.ConfigFilePath()); err != nil {
+		return fmt.Errorf("install snapshot %v: %w", snapshot.Id, err)
+	}
+	zap.S().Infof("Restored self backup snapshot %v to %q", snapshot.Id, config.DataDir())
+	return nil
+}
+
+// openOplog opens the operation log in the data directory with the store selected by -oplog-store.
+func openOplog() (string, oplog.OpLog, error) {
+	switch *OplogStore {
+	case "bbolt":
+		file := path.Join(config.DataDir(), "oplog.boltdb")
+		log, err := oplog.NewBoltStore(file)
+		if err != nil {
+			return file, nil, err
+		}
+		if *CheckOplogIntegrity {
+			if err := log.CheckIntegrity(); err != nil {
+				log.Close()
+				return file, nil, err
+			}
+		}
+		return file, log, nil
+	case "sqlite":
+		file := path.Join(config.DataDir(), "oplog.sqlite")
+		log, err := oplog.NewSqliteStore(file)
+		if err != nil {
+			return file, nil, err
+		}
+		return file, log, nil
+	default:
+		return "", nil, fmt.Errorf("unknown oplog store %q, must be bbolt or sqlite", *OplogStore)
+	}
+}
+
 func onterm(callback func()) {
 	sigchan := make(chan os.Signal, 1)
 	signal.Notify(sigchan, os.Interrupt, syscall.SIGTERM)
@@ -727,6 +727,302 @@ func (x *RepoStats) GetSnapshotCount() int64 {
 	return 0
 }
 
+// PruneStats summarizes the space reclaimed by a prune, parsed from restic's prune output.
+type PruneStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BlobsTotal         int64   `protobuf:"varint,1,opt,name=blobs_total,json=blobsTotal,proto3" json:"blobs_total,omitempty"`                             // blobs in the repo before the prune.
+	BytesTotal         int64   `protobuf:"varint,2,opt,name=bytes_total,json=bytesTotal,proto3" json:"bytes_total,omitempty"`                             // bytes in the repo before the prune.
+	BlobsUnused        int64   `protobuf:"varint,3,opt,name=blobs_unused,json=blobsUnused,proto3" json:"blobs_unused,omitempty"`                          // unused blobs before the prune.
+	BytesUnused        int64   `protobuf:"varint,4,opt,name=bytes_unused,json=bytesUnused,proto3" json:"bytes_unused,omitempty"`                          // unused bytes before the prune.
+	BlobsRepacked      int64   `protobuf:"varint,5,opt,name=blobs_repacked,json=blobsRepacked,proto3" json:"blobs_repacked,omitempty"`                    // blobs rewritten into new packs.
+	BytesRepacked      int64   `protobuf:"varint,6,opt,name=bytes_repacked,json=bytesRepacked,proto3" json:"bytes_repacked,omitempty"`                    // bytes rewritten into new packs.
+	BlobsRemoved       int64   `protobuf:"varint,7,opt,name=blobs_removed,json=blobsRemoved,proto3" json:"blobs_removed,omitempty"`                       // blobs removed, either deleted outright or dropped while repacking.
+	BytesRemoved       int64   `protobuf:"varint,8,opt,name=bytes_removed,json=bytesRemoved,proto3" json:"bytes_removed,omitempty"`                       // bytes freed by the prune.
+	BlobsRemaining     int64   `protobuf:"varint,9,opt,name=blobs_remaining,json=blobsRemaining,proto3" json:"blobs_remaining,omitempty"`                 // blobs remaining after the prune.
+	BytesRemaining     int64   `protobuf:"varint,10,opt,name=bytes_remaining,json=bytesRemaining,proto3" json:"bytes_remaining,omitempty"`                // bytes remaining after the prune.
+	BytesUnusedAfter   int64   `protobuf:"varint,11,opt,name=bytes_unused_after,json=bytesUnusedAfter,proto3" json:"bytes_unused_after,omitempty"`        // unused bytes remaining after the prune.
+	PercentUnusedAfter float64 `protobuf:"fixed64,12,opt,name=percent_unused_after,json=percentUnusedAfter,proto3" json:"percent_unused_after,omitempty"` // unused bytes remaining as a percentage of the remaining size.
+	PacksRepacked      int64   `protobuf:"varint,13,opt,name=packs_repacked,json=packsRepacked,proto3" json:"packs_repacked,omitempty"`
+	PacksRemoved       int64   `protobuf:"varint,14,opt,name=packs_removed,json=packsRemoved,proto3" json:"packs_removed,omitempty"`
+}
+
+func (x *PruneStats) Reset() {
+	*x = PruneStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_restic_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PruneStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PruneStats) ProtoMessage() {}
+
+func (x *PruneStats) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_restic_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PruneStats.ProtoReflect.Descriptor instead.
+func (*PruneStats) Descriptor() ([]byte, []int) {
+	return file_v1_restic_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *PruneStats) GetBlobsTotal() int64 {
+	if x != nil {
+		return x.BlobsTotal
+	}
+	return 0
+}
+
+func (x *PruneStats) GetBytesTotal() int64 {
+	if x != nil {
+		return x.BytesTotal
+	}
+	return 0
+}
+
+func (x *PruneStats) GetBlobsUnused() int64 {
+	if x != nil {
+		return x.BlobsUnused
+	}
+	return 0
+}
+
+func (x *PruneStats) GetBytesUnused() int64 {
+	if x != nil {
+		return x.BytesUnused
+	}
+	return 0
+}
+
+func (x *PruneStats) GetBlobsRepacked() int64 {
+	if x != nil {
+		return x.BlobsRepacked
+	}
+	return 0
+}
+
+func (x *PruneStats) GetBytesRepacked() int64 {
+	if x != nil {
+		return x.BytesRepacked
+	}
+	return 0
+}
+
+func (x *PruneStats) GetBlobsRemoved() int64 {
+	if x != nil {
+		return x.BlobsRemoved
+	}
+	return 0
+}
+
+func (x *PruneStats) GetBytesRemoved() int64 {
+	if x != nil {
+		return x.BytesRemoved
+	}
+	return 0
+}
+
+func (x *PruneStats) GetBlobsRemaining() int64 {
+	if x != nil {
+		return x.BlobsRemaining
+	}
+	return 0
+}
+
+func (x *PruneStats) GetBytesRemaining() int64 {
+	if x != nil {
+		return x.BytesRemaining
+	}
+	return 0
+}
+
+func (x *PruneStats) GetBytesUnusedAfter() int64 {
+	if x != nil {
+		return x.BytesUnusedAfter
+	}
+	return 0
+}
+
+func (x *PruneStats) GetPercentUnusedAfter() float64 {
+	if x != nil {
+		return x.PercentUnusedAfter
+	}
+	return 0
+}
+
+func (x *PruneStats) GetPacksRepacked() int64 {
+	if x != nil {
+		return x.PacksRepacked
+	}
+	return 0
+}
+
+func (x *PruneStats) GetPacksRemoved() int64 {
+	if x != nil {
+		return x.PacksRemoved
+	}
+	return 0
+}
+
+// ResticLock represents a lock held on a restic repo.
+type ResticLock struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UnixTimeMs int64  `protobuf:"varint,2,opt,name=unix_time_ms,json=unixTimeMs,proto3" json:"unix_time_ms,omitempty"` // time the lock was created or last refreshed.
+	Exclusive  bool   `protobuf:"varint,3,opt,name=exclusive,proto3" json:"exclusive,omitempty"`
+	Hostname   string `protobuf:"bytes,4,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	Username   string `protobuf:"bytes,5,opt,name=username,proto3" json:"username,omitempty"`
+	Pid        int64  `protobuf:"varint,6,opt,name=pid,proto3" json:"pid,omitempty"`
+	Stale      bool   `protobuf:"varint,7,opt,name=stale,proto3" json:"stale,omitempty"` // true if the lock would be removed by auto unlock.
+}
+
+func (x *ResticLock) Reset() {
+	*x = ResticLock{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_restic_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResticLock) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResticLock) ProtoMessage() {}
+
+func (x *ResticLock) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_restic_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResticLock.ProtoReflect.Descriptor instead.
+func (*ResticLock) Descriptor() ([]byte, []int) {
+	return file_v1_restic_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ResticLock) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ResticLock) GetUnixTimeMs() int64 {
+	if x != nil {
+		return x.UnixTimeMs
+	}
+	return 0
+}
+
+func (x *ResticLock) GetExclusive() bool {
+	if x != nil {
+		return x.Exclusive
+	}
+	return false
+}
+
+func (x *ResticLock) GetHostname() string {
+	if x != nil {
+		return x.Hostname
+	}
+	return ""
+}
+
+func (x *ResticLock) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *ResticLock) GetPid() int64 {
+	if x != nil {
+		return x.Pid
+	}
+	return 0
+}
+
+func (x *ResticLock) GetStale() bool {
+	if x != nil {
+		return x.Stale
+	}
+	return false
+}
+
+// ResticLockList represents a list of locks held on a restic repo.
+type ResticLockList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Locks []*ResticLock `protobuf:"bytes,1,rep,name=locks,proto3" json:"locks,omitempty"`
+}
+
+func (x *ResticLockList) Reset() {
+	*x = ResticLockList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_restic_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResticLockList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResticLockList) ProtoMessage() {}
+
+func (x *ResticLockList) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_restic_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResticLockList.ProtoReflect.Descriptor instead.
+func (*ResticLockList) Descriptor() ([]byte, []int) {
+	return file_v1_restic_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ResticLockList) GetLocks() []*ResticLock {
+	if x != nil {
+		return x.Locks
+	}
+	return nil
+}
+
 var File_v1_restic_proto protoreflect.FileDescriptor
 
 var file_v1_restic_proto_rawDesc = []byte{
@@ -841,10 +1137,61 @@ var file_v1_restic_proto_rawDesc = []byte{
 	0x03, 0x52, 0x0e, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x42, 0x6c, 0x6f, 0x62, 0x43, 0x6f, 0x75, 0x6e,
 	0x74, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x5f, 0x63, 0x6f,
 	0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x73, 0x6e, 0x61, 0x70, 0x73,
-	0x68, 0x6f, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x2c, 0x5a, 0x2a, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x67, 0x61, 0x72, 0x65, 0x74, 0x68, 0x67, 0x65, 0x6f,
-	0x72, 0x67, 0x65, 0x2f, 0x62, 0x61, 0x63, 0x6b, 0x72, 0x65, 0x73, 0x74, 0x2f, 0x67, 0x65, 0x6e,
-	0x2f, 0x67, 0x6f, 0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x68, 0x6f, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xaa, 0x04, 0x0a, 0x0a, 0x50, 0x72, 0x75,
+	0x6e, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x6c, 0x6f, 0x62, 0x73,
+	0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x62, 0x6c,
+	0x6f, 0x62, 0x73, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x79, 0x74, 0x65,
+	0x73, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x62,
+	0x79, 0x74, 0x65, 0x73, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f,
+	0x62, 0x73, 0x5f, 0x75, 0x6e, 0x75, 0x73, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0b, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x55, 0x6e, 0x75, 0x73, 0x65, 0x64, 0x12, 0x21, 0x0a, 0x0c,
+	0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x75, 0x6e, 0x75, 0x73, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0b, 0x62, 0x79, 0x74, 0x65, 0x73, 0x55, 0x6e, 0x75, 0x73, 0x65, 0x64, 0x12,
+	0x25, 0x0a, 0x0e, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x5f, 0x72, 0x65, 0x70, 0x61, 0x63, 0x6b, 0x65,
+	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x52, 0x65,
+	0x70, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f,
+	0x72, 0x65, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d,
+	0x62, 0x79, 0x74, 0x65, 0x73, 0x52, 0x65, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x12, 0x23, 0x0a,
+	0x0d, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x5f, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x72, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x62, 0x79, 0x74, 0x65, 0x73,
+	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x62, 0x6c, 0x6f, 0x62, 0x73,
+	0x5f, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0e, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67,
+	0x12, 0x27, 0x0a, 0x0f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e,
+	0x69, 0x6e, 0x67, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x62, 0x79, 0x74, 0x65, 0x73,
+	0x52, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x2c, 0x0a, 0x12, 0x62, 0x79, 0x74,
+	0x65, 0x73, 0x5f, 0x75, 0x6e, 0x75, 0x73, 0x65, 0x64, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x62, 0x79, 0x74, 0x65, 0x73, 0x55, 0x6e, 0x75, 0x73,
+	0x65, 0x64, 0x41, 0x66, 0x74, 0x65, 0x72, 0x12, 0x30, 0x0a, 0x14, 0x70, 0x65, 0x72, 0x63, 0x65,
+	0x6e, 0x74, 0x5f, 0x75, 0x6e, 0x75, 0x73, 0x65, 0x64, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x01, 0x52, 0x12, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x55, 0x6e,
+	0x75, 0x73, 0x65, 0x64, 0x41, 0x66, 0x74, 0x65, 0x72, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x61, 0x63,
+	0x6b, 0x73, 0x5f, 0x72, 0x65, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0d, 0x70, 0x61, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x64,
+	0x12, 0x23, 0x0a, 0x0d, 0x70, 0x61, 0x63, 0x6b, 0x73, 0x5f, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x70, 0x61, 0x63, 0x6b, 0x73, 0x52, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x64, 0x22, 0xbc, 0x01, 0x0a, 0x0a, 0x52, 0x65, 0x73, 0x74, 0x69, 0x63,
+	0x4c, 0x6f, 0x63, 0x6b, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x20, 0x0a, 0x0c, 0x75, 0x6e, 0x69, 0x78, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x5f, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x75, 0x6e, 0x69, 0x78,
+	0x54, 0x69, 0x6d, 0x65, 0x4d, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x73,
+	0x69, 0x76, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x65, 0x78, 0x63, 0x6c, 0x75,
+	0x73, 0x69, 0x76, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03,
+	0x70, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x70, 0x69, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x73, 0x74, 0x61, 0x6c, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x73,
+	0x74, 0x61, 0x6c, 0x65, 0x22, 0x36, 0x0a, 0x0e, 0x52, 0x65, 0x73, 0x74, 0x69, 0x63, 0x4c, 0x6f,
+	0x63, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x05, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x69,
+	0x63, 0x4c, 0x6f, 0x63, 0x6b, 0x52, 0x05, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x42, 0x2c, 0x5a, 0x2a,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x67, 0x61, 0x72, 0x65, 0x74,
+	0x68, 0x67, 0x65, 0x6f, 0x72, 0x67, 0x65, 0x2f, 0x62, 0x61, 0x63, 0x6b, 0x72, 0x65, 0x73, 0x74,
+	0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x67, 0x6f, 0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 }
 
 var (
@@ -859,7 +1206,7 @@ func file_v1_restic_proto_rawDescGZIP() []byte {
 	return file_v1_restic_proto_rawDescData
 }
 
-var file_v1_restic_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_v1_restic_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
 var file_v1_restic_proto_goTypes = []interface{}{
 	(*ResticSnapshot)(nil),            // 0: v1.ResticSnapshot
 	(*ResticSnapshotList)(nil),        // 1: v1.ResticSnapshotList
@@ -869,16 +1216,20 @@ var file_v1_restic_proto_goTypes = []interface{}{
 	(*BackupProgressError)(nil),       // 5: v1.BackupProgressError
 	(*RestoreProgressEntry)(nil),      // 6: v1.RestoreProgressEntry
 	(*RepoStats)(nil),                 // 7: v1.RepoStats
+	(*PruneStats)(nil),                // 8: v1.PruneStats
+	(*ResticLock)(nil),                // 9: v1.ResticLock
+	(*ResticLockList)(nil),            // 10: v1.ResticLockList
 }
 var file_v1_restic_proto_depIdxs = []int32{
 	0, // 0: v1.ResticSnapshotList.snapshots:type_name -> v1.ResticSnapshot
 	3, // 1: v1.BackupProgressEntry.status:type_name -> v1.BackupProgressStatusEntry
 	4, // 2: v1.BackupProgressEntry.summary:type_name -> v1.BackupProgressSummary
-	3, // [3:3] is the sub-list for method output_type
-	3, // [3:3] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	9, // 3: v1.ResticLockList.locks:type_name -> v1.ResticLock
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
 }
 
 func init() { file_v1_restic_proto_init() }
@@ -983,6 +1334,42 @@ func file_v1_restic_proto_init() {
 				return nil
 			}
 		}
+		file_v1_restic_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PruneStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_restic_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResticLock); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_restic_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResticLockList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	file_v1_restic_proto_msgTypes[2].OneofWrappers = []interface{}{
 		(*BackupProgressEntry_Status)(nil),
@@ -994,7 +1381,7 @@ func file_v1_restic_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_v1_restic_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   8,
+			NumMessages:   11,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
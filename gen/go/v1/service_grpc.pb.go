@@ -21,24 +21,30 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Backrest_GetConfig_FullMethodName          = "/v1.Backrest/GetConfig"
-	Backrest_SetConfig_FullMethodName          = "/v1.Backrest/SetConfig"
-	Backrest_AddRepo_FullMethodName            = "/v1.Backrest/AddRepo"
-	Backrest_GetOperationEvents_FullMethodName = "/v1.Backrest/GetOperationEvents"
-	Backrest_GetOperations_FullMethodName      = "/v1.Backrest/GetOperations"
-	Backrest_ListSnapshots_FullMethodName      = "/v1.Backrest/ListSnapshots"
-	Backrest_ListSnapshotFiles_FullMethodName  = "/v1.Backrest/ListSnapshotFiles"
-	Backrest_IndexSnapshots_FullMethodName     = "/v1.Backrest/IndexSnapshots"
-	Backrest_Backup_FullMethodName             = "/v1.Backrest/Backup"
-	Backrest_Prune_FullMethodName              = "/v1.Backrest/Prune"
-	Backrest_Forget_FullMethodName             = "/v1.Backrest/Forget"
-	Backrest_Restore_FullMethodName            = "/v1.Backrest/Restore"
-	Backrest_Unlock_FullMethodName             = "/v1.Backrest/Unlock"
-	Backrest_Stats_FullMethodName              = "/v1.Backrest/Stats"
-	Backrest_Cancel_FullMethodName             = "/v1.Backrest/Cancel"
-	Backrest_GetLogs_FullMethodName            = "/v1.Backrest/GetLogs"
-	Backrest_ClearHistory_FullMethodName       = "/v1.Backrest/ClearHistory"
-	Backrest_PathAutocomplete_FullMethodName   = "/v1.Backrest/PathAutocomplete"
+	Backrest_GetConfig_FullMethodName            = "/v1.Backrest/GetConfig"
+	Backrest_SetConfig_FullMethodName            = "/v1.Backrest/SetConfig"
+	Backrest_AddRepo_FullMethodName              = "/v1.Backrest/AddRepo"
+	Backrest_GetOperationEvents_FullMethodName   = "/v1.Backrest/GetOperationEvents"
+	Backrest_GetOperations_FullMethodName        = "/v1.Backrest/GetOperations"
+	Backrest_ExportOperations_FullMethodName     = "/v1.Backrest/ExportOperations"
+	Backrest_ImportOperations_FullMethodName     = "/v1.Backrest/ImportOperations"
+	Backrest_ListSnapshots_FullMethodName        = "/v1.Backrest/ListSnapshots"
+	Backrest_ListSnapshotFiles_FullMethodName    = "/v1.Backrest/ListSnapshotFiles"
+	Backrest_IndexSnapshots_FullMethodName       = "/v1.Backrest/IndexSnapshots"
+	Backrest_Backup_FullMethodName               = "/v1.Backrest/Backup"
+	Backrest_Prune_FullMethodName                = "/v1.Backrest/Prune"
+	Backrest_Forget_FullMethodName               = "/v1.Backrest/Forget"
+	Backrest_Restore_FullMethodName              = "/v1.Backrest/Restore"
+	Backrest_Unlock_FullMethodName               = "/v1.Backrest/Unlock"
+	Backrest_GetRepoLocks_FullMethodName         = "/v1.Backrest/GetRepoLocks"
+	Backrest_Stats_FullMethodName                = "/v1.Backrest/Stats"
+	Backrest_Cancel_FullMethodName               = "/v1.Backrest/Cancel"
+	Backrest_GetLogs_FullMethodName              = "/v1.Backrest/GetLogs"
+	Backrest_ClearHistory_FullMethodName         = "/v1.Backrest/ClearHistory"
+	Backrest_RunGarbageCollection_FullMethodName = "/v1.Backrest/RunGarbageCollection"
+	Backrest_GetPlanMetrics_FullMethodName       = "/v1.Backrest/GetPlanMetrics"
+	Backrest_TestHook_FullMethodName             = "/v1.Backrest/TestHook"
+	Backrest_PathAutocomplete_FullMethodName     = "/v1.Backrest/PathAutocomplete"
 )
 
 // BackrestClient is the client API for Backrest service.
@@ -48,8 +54,13 @@ type BackrestClient interface {
 	GetConfig(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*Config, error)
 	SetConfig(ctx context.Context, in *Config, opts ...grpc.CallOption) (*Config, error)
 	AddRepo(ctx context.Context, in *Repo, opts ...grpc.CallOption) (*Config, error)
-	GetOperationEvents(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (Backrest_GetOperationEventsClient, error)
+	// GetOperationEvents streams changes to the operation log. If since_seq is set the changes after it are replayed first.
+	GetOperationEvents(ctx context.Context, in *GetOperationEventsRequest, opts ...grpc.CallOption) (Backrest_GetOperationEventsClient, error)
 	GetOperations(ctx context.Context, in *GetOperationsRequest, opts ...grpc.CallOption) (*OperationList, error)
+	// ExportOperations streams the operations matching the request ordered by id, in the format of an operation log export.
+	ExportOperations(ctx context.Context, in *ExportOperationsRequest, opts ...grpc.CallOption) (Backrest_ExportOperationsClient, error)
+	// ImportOperations adds the streamed operations to the log with new ids and returns the number of operations imported.
+	ImportOperations(ctx context.Context, opts ...grpc.CallOption) (Backrest_ImportOperationsClient, error)
 	ListSnapshots(ctx context.Context, in *ListSnapshotsRequest, opts ...grpc.CallOption) (*ResticSnapshotList, error)
 	ListSnapshotFiles(ctx context.Context, in *ListSnapshotFilesRequest, opts ...grpc.CallOption) (*ListSnapshotFilesResponse, error)
 	// IndexSnapshots triggers indexin. It accepts a repo id and returns empty if the task is enqueued.
@@ -64,6 +75,8 @@ type BackrestClient interface {
 	Restore(ctx context.Context, in *RestoreSnapshotRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Unlock synchronously attempts to unlock the repo. Will block if other operations are in progress.
 	Unlock(ctx context.Context, in *types.StringValue, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// GetRepoLocks lists the locks held on the repo and who holds them. It accepts a repo id.
+	GetRepoLocks(ctx context.Context, in *types.StringValue, opts ...grpc.CallOption) (*ResticLockList, error)
 	// Stats runs 'restic stats` on the repository and appends the results to the operations log.
 	Stats(ctx context.Context, in *types.StringValue, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Cancel attempts to cancel a task with the given operation ID. Not guaranteed to succeed.
@@ -72,6 +85,13 @@ type BackrestClient interface {
 	GetLogs(ctx context.Context, in *LogDataRequest, opts ...grpc.CallOption) (*types.BytesValue, error)
 	// Clears the history of operations
 	ClearHistory(ctx context.Context, in *ClearHistoryRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// RunGarbageCollection removes old operations from the history according to the gc policy and returns what was removed.
+	RunGarbageCollection(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GarbageCollectionResult, error)
+	// GetPlanMetrics returns the plan's backup metrics rolled up into daily or weekly buckets.
+	GetPlanMetrics(ctx context.Context, in *GetPlanMetricsRequest, opts ...grpc.CallOption) (*PlanMetrics, error)
+	// TestHook runs a hook once for a condition with variables built from the plan's most recent operations and returns what it
+	// sent. The run is not recorded in the operation history.
+	TestHook(ctx context.Context, in *TestHookRequest, opts ...grpc.CallOption) (*TestHookResponse, error)
 	// PathAutocomplete provides path autocompletion options for a given filesystem path.
 	PathAutocomplete(ctx context.Context, in *types.StringValue, opts ...grpc.CallOption) (*types.StringList, error)
 }
@@ -111,7 +131,7 @@ func (c *backrestClient) AddRepo(ctx context.Context, in *Repo, opts ...grpc.Cal
 	return out, nil
 }
 
-func (c *backrestClient) GetOperationEvents(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (Backrest_GetOperationEventsClient, error) {
+func (c *backrestClient) GetOperationEvents(ctx context.Context, in *GetOperationEventsRequest, opts ...grpc.CallOption) (Backrest_GetOperationEventsClient, error) {
 	stream, err := c.cc.NewStream(ctx, &Backrest_ServiceDesc.Streams[0], Backrest_GetOperationEvents_FullMethodName, opts...)
 	if err != nil {
 		return nil, err
@@ -152,6 +172,72 @@ func (c *backrestClient) GetOperations(ctx context.Context, in *GetOperationsReq
 	return out, nil
 }
 
+func (c *backrestClient) ExportOperations(ctx context.Context, in *ExportOperationsRequest, opts ...grpc.CallOption) (Backrest_ExportOperationsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Backrest_ServiceDesc.Streams[1], Backrest_ExportOperations_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &backrestExportOperationsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Backrest_ExportOperationsClient interface {
+	Recv() (*ExportedOperation, error)
+	grpc.ClientStream
+}
+
+type backrestExportOperationsClient struct {
+	grpc.ClientStream
+}
+
+func (x *backrestExportOperationsClient) Recv() (*ExportedOperation, error) {
+	m := new(ExportedOperation)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *backrestClient) ImportOperations(ctx context.Context, opts ...grpc.CallOption) (Backrest_ImportOperationsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Backrest_ServiceDesc.Streams[2], Backrest_ImportOperations_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &backrestImportOperationsClient{stream}
+	return x, nil
+}
+
+type Backrest_ImportOperationsClient interface {
+	Send(*ImportOperationsRequest) error
+	CloseAndRecv() (*types.Int64Value, error)
+	grpc.ClientStream
+}
+
+type backrestImportOperationsClient struct {
+	grpc.ClientStream
+}
+
+func (x *backrestImportOperationsClient) Send(m *ImportOperationsRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *backrestImportOperationsClient) CloseAndRecv() (*types.Int64Value, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(types.Int64Value)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *backrestClient) ListSnapshots(ctx context.Context, in *ListSnapshotsRequest, opts ...grpc.CallOption) (*ResticSnapshotList, error) {
 	out := new(ResticSnapshotList)
 	err := c.cc.Invoke(ctx, Backrest_ListSnapshots_FullMethodName, in, out, opts...)
@@ -224,6 +310,15 @@ func (c *backrestClient) Unlock(ctx context.Context, in *types.StringValue, opts
 	return out, nil
 }
 
+func (c *backrestClient) GetRepoLocks(ctx context.Context, in *types.StringValue, opts ...grpc.CallOption) (*ResticLockList, error) {
+	out := new(ResticLockList)
+	err := c.cc.Invoke(ctx, Backrest_GetRepoLocks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *backrestClient) Stats(ctx context.Context, in *types.StringValue, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, Backrest_Stats_FullMethodName, in, out, opts...)
@@ -260,6 +355,33 @@ func (c *backrestClient) ClearHistory(ctx context.Context, in *ClearHistoryReque
 	return out, nil
 }
 
+func (c *backrestClient) RunGarbageCollection(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GarbageCollectionResult, error) {
+	out := new(GarbageCollectionResult)
+	err := c.cc.Invoke(ctx, Backrest_RunGarbageCollection_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *backrestClient) GetPlanMetrics(ctx context.Context, in *GetPlanMetricsRequest, opts ...grpc.CallOption) (*PlanMetrics, error) {
+	out := new(PlanMetrics)
+	err := c.cc.Invoke(ctx, Backrest_GetPlanMetrics_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *backrestClient) TestHook(ctx context.Context, in *TestHookRequest, opts ...grpc.CallOption) (*TestHookResponse, error) {
+	out := new(TestHookResponse)
+	err := c.cc.Invoke(ctx, Backrest_TestHook_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *backrestClient) PathAutocomplete(ctx context.Context, in *types.StringValue, opts ...grpc.CallOption) (*types.StringList, error) {
 	out := new(types.StringList)
 	err := c.cc.Invoke(ctx, Backrest_PathAutocomplete_FullMethodName, in, out, opts...)
@@ -276,8 +398,13 @@ type BackrestServer interface {
 	GetConfig(context.Context, *emptypb.Empty) (*Config, error)
 	SetConfig(context.Context, *Config) (*Config, error)
 	AddRepo(context.Context, *Repo) (*Config, error)
-	GetOperationEvents(*emptypb.Empty, Backrest_GetOperationEventsServer) error
+	// GetOperationEvents streams changes to the operation log. If since_seq is set the changes after it are replayed first.
+	GetOperationEvents(*GetOperationEventsRequest, Backrest_GetOperationEventsServer) error
 	GetOperations(context.Context, *GetOperationsRequest) (*OperationList, error)
+	// ExportOperations streams the operations matching the request ordered by id, in the format of an operation log export.
+	ExportOperations(*ExportOperationsRequest, Backrest_ExportOperationsServer) error
+	// ImportOperations adds the streamed operations to the log with new ids and returns the number of operations imported.
+	ImportOperations(Backrest_ImportOperationsServer) error
 	ListSnapshots(context.Context, *ListSnapshotsRequest) (*ResticSnapshotList, error)
 	ListSnapshotFiles(context.Context, *ListSnapshotFilesRequest) (*ListSnapshotFilesResponse, error)
 	// IndexSnapshots triggers indexin. It accepts a repo id and returns empty if the task is enqueued.
@@ -292,6 +419,8 @@ type BackrestServer interface {
 	Restore(context.Context, *RestoreSnapshotRequest) (*emptypb.Empty, error)
 	// Unlock synchronously attempts to unlock the repo. Will block if other operations are in progress.
 	Unlock(context.Context, *types.StringValue) (*emptypb.Empty, error)
+	// GetRepoLocks lists the locks held on the repo and who holds them. It accepts a repo id.
+	GetRepoLocks(context.Context, *types.StringValue) (*ResticLockList, error)
 	// Stats runs 'restic stats` on the repository and appends the results to the operations log.
 	Stats(context.Context, *types.StringValue) (*emptypb.Empty, error)
 	// Cancel attempts to cancel a task with the given operation ID. Not guaranteed to succeed.
@@ -300,6 +429,13 @@ type BackrestServer interface {
 	GetLogs(context.Context, *LogDataRequest) (*types.BytesValue, error)
 	// Clears the history of operations
 	ClearHistory(context.Context, *ClearHistoryRequest) (*emptypb.Empty, error)
+	// RunGarbageCollection removes old operations from the history according to the gc policy and returns what was removed.
+	RunGarbageCollection(context.Context, *emptypb.Empty) (*GarbageCollectionResult, error)
+	// GetPlanMetrics returns the plan's backup metrics rolled up into daily or weekly buckets.
+	GetPlanMetrics(context.Context, *GetPlanMetricsRequest) (*PlanMetrics, error)
+	// TestHook runs a hook once for a condition with variables built from the plan's most recent operations and returns what it
+	// sent. The run is not recorded in the operation history.
+	TestHook(context.Context, *TestHookRequest) (*TestHookResponse, error)
 	// PathAutocomplete provides path autocompletion options for a given filesystem path.
 	PathAutocomplete(context.Context, *types.StringValue) (*types.StringList, error)
 	mustEmbedUnimplementedBackrestServer()
@@ -318,12 +454,18 @@ func (UnimplementedBackrestServer) SetConfig(context.Context, *Config) (*Config,
 func (UnimplementedBackrestServer) AddRepo(context.Context, *Repo) (*Config, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddRepo not implemented")
 }
-func (UnimplementedBackrestServer) GetOperationEvents(*emptypb.Empty, Backrest_GetOperationEventsServer) error {
+func (UnimplementedBackrestServer) GetOperationEvents(*GetOperationEventsRequest, Backrest_GetOperationEventsServer) error {
 	return status.Errorf(codes.Unimplemented, "method GetOperationEvents not implemented")
 }
 func (UnimplementedBackrestServer) GetOperations(context.Context, *GetOperationsRequest) (*OperationList, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetOperations not implemented")
 }
+func (UnimplementedBackrestServer) ExportOperations(*ExportOperationsRequest, Backrest_ExportOperationsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportOperations not implemented")
+}
+func (UnimplementedBackrestServer) ImportOperations(Backrest_ImportOperationsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ImportOperations not implemented")
+}
 func (UnimplementedBackrestServer) ListSnapshots(context.Context, *ListSnapshotsRequest) (*ResticSnapshotList, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListSnapshots not implemented")
 }
@@ -348,6 +490,9 @@ func (UnimplementedBackrestServer) Restore(context.Context, *RestoreSnapshotRequ
 func (UnimplementedBackrestServer) Unlock(context.Context, *types.StringValue) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Unlock not implemented")
 }
+func (UnimplementedBackrestServer) GetRepoLocks(context.Context, *types.StringValue) (*ResticLockList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRepoLocks not implemented")
+}
 func (UnimplementedBackrestServer) Stats(context.Context, *types.StringValue) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Stats not implemented")
 }
@@ -360,6 +505,15 @@ func (UnimplementedBackrestServer) GetLogs(context.Context, *LogDataRequest) (*t
 func (UnimplementedBackrestServer) ClearHistory(context.Context, *ClearHistoryRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ClearHistory not implemented")
 }
+func (UnimplementedBackrestServer) RunGarbageCollection(context.Context, *emptypb.Empty) (*GarbageCollectionResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RunGarbageCollection not implemented")
+}
+func (UnimplementedBackrestServer) GetPlanMetrics(context.Context, *GetPlanMetricsRequest) (*PlanMetrics, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPlanMetrics not implemented")
+}
+func (UnimplementedBackrestServer) TestHook(context.Context, *TestHookRequest) (*TestHookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TestHook not implemented")
+}
 func (UnimplementedBackrestServer) PathAutocomplete(context.Context, *types.StringValue) (*types.StringList, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PathAutocomplete not implemented")
 }
@@ -431,7 +585,7 @@ func _Backrest_AddRepo_Handler(srv interface{}, ctx context.Context, dec func(in
 }
 
 func _Backrest_GetOperationEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(emptypb.Empty)
+	m := new(GetOperationEventsRequest)
 	if err := stream.RecvMsg(m); err != nil {
 		return err
 	}
@@ -469,6 +623,53 @@ func _Backrest_GetOperations_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Backrest_ExportOperations_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportOperationsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BackrestServer).ExportOperations(m, &backrestExportOperationsServer{stream})
+}
+
+type Backrest_ExportOperationsServer interface {
+	Send(*ExportedOperation) error
+	grpc.ServerStream
+}
+
+type backrestExportOperationsServer struct {
+	grpc.ServerStream
+}
+
+func (x *backrestExportOperationsServer) Send(m *ExportedOperation) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Backrest_ImportOperations_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(BackrestServer).ImportOperations(&backrestImportOperationsServer{stream})
+}
+
+type Backrest_ImportOperationsServer interface {
+	SendAndClose(*types.Int64Value) error
+	Recv() (*ImportOperationsRequest, error)
+	grpc.ServerStream
+}
+
+type backrestImportOperationsServer struct {
+	grpc.ServerStream
+}
+
+func (x *backrestImportOperationsServer) SendAndClose(m *types.Int64Value) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *backrestImportOperationsServer) Recv() (*ImportOperationsRequest, error) {
+	m := new(ImportOperationsRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func _Backrest_ListSnapshots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListSnapshotsRequest)
 	if err := dec(in); err != nil {
@@ -613,6 +814,24 @@ func _Backrest_Unlock_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Backrest_GetRepoLocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.StringValue)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BackrestServer).GetRepoLocks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Backrest_GetRepoLocks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BackrestServer).GetRepoLocks(ctx, req.(*types.StringValue))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Backrest_Stats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(types.StringValue)
 	if err := dec(in); err != nil {
@@ -685,6 +904,60 @@ func _Backrest_ClearHistory_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Backrest_RunGarbageCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BackrestServer).RunGarbageCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Backrest_RunGarbageCollection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BackrestServer).RunGarbageCollection(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Backrest_GetPlanMetrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPlanMetricsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BackrestServer).GetPlanMetrics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Backrest_GetPlanMetrics_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BackrestServer).GetPlanMetrics(ctx, req.(*GetPlanMetricsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Backrest_TestHook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TestHookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BackrestServer).TestHook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Backrest_TestHook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BackrestServer).TestHook(ctx, req.(*TestHookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Backrest_PathAutocomplete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(types.StringValue)
 	if err := dec(in); err != nil {
@@ -758,6 +1031,10 @@ var Backrest_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Unlock",
 			Handler:    _Backrest_Unlock_Handler,
 		},
+		{
+			MethodName: "GetRepoLocks",
+			Handler:    _Backrest_GetRepoLocks_Handler,
+		},
 		{
 			MethodName: "Stats",
 			Handler:    _Backrest_Stats_Handler,
@@ -774,6 +1051,18 @@ var Backrest_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ClearHistory",
 			Handler:    _Backrest_ClearHistory_Handler,
 		},
+		{
+			MethodName: "RunGarbageCollection",
+			Handler:    _Backrest_RunGarbageCollection_Handler,
+		},
+		{
+			MethodName: "GetPlanMetrics",
+			Handler:    _Backrest_GetPlanMetrics_Handler,
+		},
+		{
+			MethodName: "TestHook",
+			Handler:    _Backrest_TestHook_Handler,
+		},
 		{
 			MethodName: "PathAutocomplete",
 			Handler:    _Backrest_PathAutocomplete_Handler,
@@ -785,6 +1074,16 @@ var Backrest_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _Backrest_GetOperationEvents_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "ExportOperations",
+			Handler:       _Backrest_ExportOperations_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ImportOperations",
+			Handler:       _Backrest_ImportOperations_Handler,
+			ClientStreams: true,
+		},
 	},
 	Metadata: "v1/service.proto",
 }
@@ -46,6 +46,12 @@ const (
 	BackrestGetOperationEventsProcedure = "/v1.Backrest/GetOperationEvents"
 	// BackrestGetOperationsProcedure is the fully-qualified name of the Backrest's GetOperations RPC.
 	BackrestGetOperationsProcedure = "/v1.Backrest/GetOperations"
+	// BackrestExportOperationsProcedure is the fully-qualified name of the Backrest's ExportOperations
+	// RPC.
+	BackrestExportOperationsProcedure = "/v1.Backrest/ExportOperations"
+	// BackrestImportOperationsProcedure is the fully-qualified name of the Backrest's ImportOperations
+	// RPC.
+	BackrestImportOperationsProcedure = "/v1.Backrest/ImportOperations"
 	// BackrestListSnapshotsProcedure is the fully-qualified name of the Backrest's ListSnapshots RPC.
 	BackrestListSnapshotsProcedure = "/v1.Backrest/ListSnapshots"
 	// BackrestListSnapshotFilesProcedure is the fully-qualified name of the Backrest's
@@ -63,6 +69,8 @@ const (
 	BackrestRestoreProcedure = "/v1.Backrest/Restore"
 	// BackrestUnlockProcedure is the fully-qualified name of the Backrest's Unlock RPC.
 	BackrestUnlockProcedure = "/v1.Backrest/Unlock"
+	// BackrestGetRepoLocksProcedure is the fully-qualified name of the Backrest's GetRepoLocks RPC.
+	BackrestGetRepoLocksProcedure = "/v1.Backrest/GetRepoLocks"
 	// BackrestStatsProcedure is the fully-qualified name of the Backrest's Stats RPC.
 	BackrestStatsProcedure = "/v1.Backrest/Stats"
 	// BackrestCancelProcedure is the fully-qualified name of the Backrest's Cancel RPC.
@@ -71,6 +79,13 @@ const (
 	BackrestGetLogsProcedure = "/v1.Backrest/GetLogs"
 	// BackrestClearHistoryProcedure is the fully-qualified name of the Backrest's ClearHistory RPC.
 	BackrestClearHistoryProcedure = "/v1.Backrest/ClearHistory"
+	// BackrestRunGarbageCollectionProcedure is the fully-qualified name of the Backrest's
+	// RunGarbageCollection RPC.
+	BackrestRunGarbageCollectionProcedure = "/v1.Backrest/RunGarbageCollection"
+	// BackrestGetPlanMetricsProcedure is the fully-qualified name of the Backrest's GetPlanMetrics RPC.
+	BackrestGetPlanMetricsProcedure = "/v1.Backrest/GetPlanMetrics"
+	// BackrestTestHookProcedure is the fully-qualified name of the Backrest's TestHook RPC.
+	BackrestTestHookProcedure = "/v1.Backrest/TestHook"
 	// BackrestPathAutocompleteProcedure is the fully-qualified name of the Backrest's PathAutocomplete
 	// RPC.
 	BackrestPathAutocompleteProcedure = "/v1.Backrest/PathAutocomplete"
@@ -78,25 +93,31 @@ const (
 
 // These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
 var (
-	backrestServiceDescriptor                  = v1.File_v1_service_proto.Services().ByName("Backrest")
-	backrestGetConfigMethodDescriptor          = backrestServiceDescriptor.Methods().ByName("GetConfig")
-	backrestSetConfigMethodDescriptor          = backrestServiceDescriptor.Methods().ByName("SetConfig")
-	backrestAddRepoMethodDescriptor            = backrestServiceDescriptor.Methods().ByName("AddRepo")
-	backrestGetOperationEventsMethodDescriptor = backrestServiceDescriptor.Methods().ByName("GetOperationEvents")
-	backrestGetOperationsMethodDescriptor      = backrestServiceDescriptor.Methods().ByName("GetOperations")
-	backrestListSnapshotsMethodDescriptor      = backrestServiceDescriptor.Methods().ByName("ListSnapshots")
-	backrestListSnapshotFilesMethodDescriptor  = backrestServiceDescriptor.Methods().ByName("ListSnapshotFiles")
-	backrestIndexSnapshotsMethodDescriptor     = backrestServiceDescriptor.Methods().ByName("IndexSnapshots")
-	backrestBackupMethodDescriptor             = backrestServiceDescriptor.Methods().ByName("Backup")
-	backrestPruneMethodDescriptor              = backrestServiceDescriptor.Methods().ByName("Prune")
-	backrestForgetMethodDescriptor             = backrestServiceDescriptor.Methods().ByName("Forget")
-	backrestRestoreMethodDescriptor            = backrestServiceDescriptor.Methods().ByName("Restore")
-	backrestUnlockMethodDescriptor             = backrestServiceDescriptor.Methods().ByName("Unlock")
-	backrestStatsMethodDescriptor              = backrestServiceDescriptor.Methods().ByName("Stats")
-	backrestCancelMethodDescriptor             = backrestServiceDescriptor.Methods().ByName("Cancel")
-	backrestGetLogsMethodDescriptor            = backrestServiceDescriptor.Methods().ByName("GetLogs")
-	backrestClearHistoryMethodDescriptor       = backrestServiceDescriptor.Methods().ByName("ClearHistory")
-	backrestPathAutocompleteMethodDescriptor   = backrestServiceDescriptor.Methods().ByName("PathAutocomplete")
+	backrestServiceDescriptor                    = v1.File_v1_service_proto.Services().ByName("Backrest")
+	backrestGetConfigMethodDescriptor            = backrestServiceDescriptor.Methods().ByName("GetConfig")
+	backrestSetConfigMethodDescriptor            = backrestServiceDescriptor.Methods().ByName("SetConfig")
+	backrestAddRepoMethodDescriptor              = backrestServiceDescriptor.Methods().ByName("AddRepo")
+	backrestGetOperationEventsMethodDescriptor   = backrestServiceDescriptor.Methods().ByName("GetOperationEvents")
+	backrestGetOperationsMethodDescriptor        = backrestServiceDescriptor.Methods().ByName("GetOperations")
+	backrestExportOperationsMethodDescriptor     = backrestServiceDescriptor.Methods().ByName("ExportOperations")
+	backrestImportOperationsMethodDescriptor     = backrestServiceDescriptor.Methods().ByName("ImportOperations")
+	backrestListSnapshotsMethodDescriptor        = backrestServiceDescriptor.Methods().ByName("ListSnapshots")
+	backrestListSnapshotFilesMethodDescriptor    = backrestServiceDescriptor.Methods().ByName("ListSnapshotFiles")
+	backrestIndexSnapshotsMethodDescriptor       = backrestServiceDescriptor.Methods().ByName("IndexSnapshots")
+	backrestBackupMethodDescriptor               = backrestServiceDescriptor.Methods().ByName("Backup")
+	backrestPruneMethodDescriptor                = backrestServiceDescriptor.Methods().ByName("Prune")
+	backrestForgetMethodDescriptor               = backrestServiceDescriptor.Methods().ByName("Forget")
+	backrestRestoreMethodDescriptor              = backrestServiceDescriptor.Methods().ByName("Restore")
+	backrestUnlockMethodDescriptor               = backrestServiceDescriptor.Methods().ByName("Unlock")
+	backrestGetRepoLocksMethodDescriptor         = backrestServiceDescriptor.Methods().ByName("GetRepoLocks")
+	backrestStatsMethodDescriptor                = backrestServiceDescriptor.Methods().ByName("Stats")
+	backrestCancelMethodDescriptor               = backrestServiceDescriptor.Methods().ByName("Cancel")
+	backrestGetLogsMethodDescriptor              = backrestServiceDescriptor.Methods().ByName("GetLogs")
+	backrestClearHistoryMethodDescriptor         = backrestServiceDescriptor.Methods().ByName("ClearHistory")
+	backrestRunGarbageCollectionMethodDescriptor = backrestServiceDescriptor.Methods().ByName("RunGarbageCollection")
+	backrestGetPlanMetricsMethodDescriptor       = backrestServiceDescriptor.Methods().ByName("GetPlanMetrics")
+	backrestTestHookMethodDescriptor             = backrestServiceDescriptor.Methods().ByName("TestHook")
+	backrestPathAutocompleteMethodDescriptor     = backrestServiceDescriptor.Methods().ByName("PathAutocomplete")
 )
 
 // BackrestClient is a client for the v1.Backrest service.
@@ -104,8 +125,13 @@ type BackrestClient interface {
 	GetConfig(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.Config], error)
 	SetConfig(context.Context, *connect.Request[v1.Config]) (*connect.Response[v1.Config], error)
 	AddRepo(context.Context, *connect.Request[v1.Repo]) (*connect.Response[v1.Config], error)
-	GetOperationEvents(context.Context, *connect.Request[emptypb.Empty]) (*connect.ServerStreamForClient[v1.OperationEvent], error)
+	// GetOperationEvents streams changes to the operation log. If since_seq is set the changes after it are replayed first.
+	GetOperationEvents(context.Context, *connect.Request[v1.GetOperationEventsRequest]) (*connect.ServerStreamForClient[v1.OperationEvent], error)
 	GetOperations(context.Context, *connect.Request[v1.GetOperationsRequest]) (*connect.Response[v1.OperationList], error)
+	// ExportOperations streams the operations matching the request ordered by id, in the format of an operation log export.
+	ExportOperations(context.Context, *connect.Request[v1.ExportOperationsRequest]) (*connect.ServerStreamForClient[v1.ExportedOperation], error)
+	// ImportOperations adds the streamed operations to the log with new ids and returns the number of operations imported.
+	ImportOperations(context.Context) *connect.ClientStreamForClient[v1.ImportOperationsRequest, types.Int64Value]
 	ListSnapshots(context.Context, *connect.Request[v1.ListSnapshotsRequest]) (*connect.Response[v1.ResticSnapshotList], error)
 	ListSnapshotFiles(context.Context, *connect.Request[v1.ListSnapshotFilesRequest]) (*connect.Response[v1.ListSnapshotFilesResponse], error)
 	// IndexSnapshots triggers indexin. It accepts a repo id and returns empty if the task is enqueued.
@@ -120,6 +146,8 @@ type BackrestClient interface {
 	Restore(context.Context, *connect.Request[v1.RestoreSnapshotRequest]) (*connect.Response[emptypb.Empty], error)
 	// Unlock synchronously attempts to unlock the repo. Will block if other operations are in progress.
 	Unlock(context.Context, *connect.Request[types.StringValue]) (*connect.Response[emptypb.Empty], error)
+	// GetRepoLocks lists the locks held on the repo and who holds them. It accepts a repo id.
+	GetRepoLocks(context.Context, *connect.Request[types.StringValue]) (*connect.Response[v1.ResticLockList], error)
 	// Stats runs 'restic stats` on the repository and appends the results to the operations log.
 	Stats(context.Context, *connect.Request[types.StringValue]) (*connect.Response[emptypb.Empty], error)
 	// Cancel attempts to cancel a task with the given operation ID. Not guaranteed to succeed.
@@ -128,6 +156,13 @@ type BackrestClient interface {
 	GetLogs(context.Context, *connect.Request[v1.LogDataRequest]) (*connect.Response[types.BytesValue], error)
 	// Clears the history of operations
 	ClearHistory(context.Context, *connect.Request[v1.ClearHistoryRequest]) (*connect.Response[emptypb.Empty], error)
+	// RunGarbageCollection removes old operations from the history according to the gc policy and returns what was removed.
+	RunGarbageCollection(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.GarbageCollectionResult], error)
+	// GetPlanMetrics returns the plan's backup metrics rolled up into daily or weekly buckets.
+	GetPlanMetrics(context.Context, *connect.Request[v1.GetPlanMetricsRequest]) (*connect.Response[v1.PlanMetrics], error)
+	// TestHook runs a hook once for a condition with variables built from the plan's most recent operations and returns what it
+	// sent. The run is not recorded in the operation history.
+	TestHook(context.Context, *connect.Request[v1.TestHookRequest]) (*connect.Response[v1.TestHookResponse], error)
 	// PathAutocomplete provides path autocompletion options for a given filesystem path.
 	PathAutocomplete(context.Context, *connect.Request[types.StringValue]) (*connect.Response[types.StringList], error)
 }
@@ -160,7 +195,7 @@ func NewBackrestClient(httpClient connect.HTTPClient, baseURL string, opts ...co
 			connect.WithSchema(backrestAddRepoMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
-		getOperationEvents: connect.NewClient[emptypb.Empty, v1.OperationEvent](
+		getOperationEvents: connect.NewClient[v1.GetOperationEventsRequest, v1.OperationEvent](
 			httpClient,
 			baseURL+BackrestGetOperationEventsProcedure,
 			connect.WithSchema(backrestGetOperationEventsMethodDescriptor),
@@ -172,6 +207,18 @@ func NewBackrestClient(httpClient connect.HTTPClient, baseURL string, opts ...co
 			connect.WithSchema(backrestGetOperationsMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		exportOperations: connect.NewClient[v1.ExportOperationsRequest, v1.ExportedOperation](
+			httpClient,
+			baseURL+BackrestExportOperationsProcedure,
+			connect.WithSchema(backrestExportOperationsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		importOperations: connect.NewClient[v1.ImportOperationsRequest, types.Int64Value](
+			httpClient,
+			baseURL+BackrestImportOperationsProcedure,
+			connect.WithSchema(backrestImportOperationsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 		listSnapshots: connect.NewClient[v1.ListSnapshotsRequest, v1.ResticSnapshotList](
 			httpClient,
 			baseURL+BackrestListSnapshotsProcedure,
@@ -220,6 +267,12 @@ func NewBackrestClient(httpClient connect.HTTPClient, baseURL string, opts ...co
 			connect.WithSchema(backrestUnlockMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		getRepoLocks: connect.NewClient[types.StringValue, v1.ResticLockList](
+			httpClient,
+			baseURL+BackrestGetRepoLocksProcedure,
+			connect.WithSchema(backrestGetRepoLocksMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 		stats: connect.NewClient[types.StringValue, emptypb.Empty](
 			httpClient,
 			baseURL+BackrestStatsProcedure,
@@ -244,6 +297,24 @@ func NewBackrestClient(httpClient connect.HTTPClient, baseURL string, opts ...co
 			connect.WithSchema(backrestClearHistoryMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		runGarbageCollection: connect.NewClient[emptypb.Empty, v1.GarbageCollectionResult](
+			httpClient,
+			baseURL+BackrestRunGarbageCollectionProcedure,
+			connect.WithSchema(backrestRunGarbageCollectionMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getPlanMetrics: connect.NewClient[v1.GetPlanMetricsRequest, v1.PlanMetrics](
+			httpClient,
+			baseURL+BackrestGetPlanMetricsProcedure,
+			connect.WithSchema(backrestGetPlanMetricsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		testHook: connect.NewClient[v1.TestHookRequest, v1.TestHookResponse](
+			httpClient,
+			baseURL+BackrestTestHookProcedure,
+			connect.WithSchema(backrestTestHookMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 		pathAutocomplete: connect.NewClient[types.StringValue, types.StringList](
 			httpClient,
 			baseURL+BackrestPathAutocompleteProcedure,
@@ -255,24 +326,30 @@ func NewBackrestClient(httpClient connect.HTTPClient, baseURL string, opts ...co
 
 // backrestClient implements BackrestClient.
 type backrestClient struct {
-	getConfig          *connect.Client[emptypb.Empty, v1.Config]
-	setConfig          *connect.Client[v1.Config, v1.Config]
-	addRepo            *connect.Client[v1.Repo, v1.Config]
-	getOperationEvents *connect.Client[emptypb.Empty, v1.OperationEvent]
-	getOperations      *connect.Client[v1.GetOperationsRequest, v1.OperationList]
-	listSnapshots      *connect.Client[v1.ListSnapshotsRequest, v1.ResticSnapshotList]
-	listSnapshotFiles  *connect.Client[v1.ListSnapshotFilesRequest, v1.ListSnapshotFilesResponse]
-	indexSnapshots     *connect.Client[types.StringValue, emptypb.Empty]
-	backup             *connect.Client[types.StringValue, emptypb.Empty]
-	prune              *connect.Client[types.StringValue, emptypb.Empty]
-	forget             *connect.Client[v1.ForgetRequest, emptypb.Empty]
-	restore            *connect.Client[v1.RestoreSnapshotRequest, emptypb.Empty]
-	unlock             *connect.Client[types.StringValue, emptypb.Empty]
-	stats              *connect.Client[types.StringValue, emptypb.Empty]
-	cancel             *connect.Client[types.Int64Value, emptypb.Empty]
-	getLogs            *connect.Client[v1.LogDataRequest, types.BytesValue]
-	clearHistory       *connect.Client[v1.ClearHistoryRequest, emptypb.Empty]
-	pathAutocomplete   *connect.Client[types.StringValue, types.StringList]
+	getConfig            *connect.Client[emptypb.Empty, v1.Config]
+	setConfig            *connect.Client[v1.Config, v1.Config]
+	addRepo              *connect.Client[v1.Repo, v1.Config]
+	getOperationEvents   *connect.Client[v1.GetOperationEventsRequest, v1.OperationEvent]
+	getOperations        *connect.Client[v1.GetOperationsRequest, v1.OperationList]
+	exportOperations     *connect.Client[v1.ExportOperationsRequest, v1.ExportedOperation]
+	importOperations     *connect.Client[v1.ImportOperationsRequest, types.Int64Value]
+	listSnapshots        *connect.Client[v1.ListSnapshotsRequest, v1.ResticSnapshotList]
+	listSnapshotFiles    *connect.Client[v1.ListSnapshotFilesRequest, v1.ListSnapshotFilesResponse]
+	indexSnapshots       *connect.Client[types.StringValue, emptypb.Empty]
+	backup               *connect.Client[types.StringValue, emptypb.Empty]
+	prune                *connect.Client[types.StringValue, emptypb.Empty]
+	forget               *connect.Client[v1.ForgetRequest, emptypb.Empty]
+	restore              *connect.Client[v1.RestoreSnapshotRequest, emptypb.Empty]
+	unlock               *connect.Client[types.StringValue, emptypb.Empty]
+	getRepoLocks         *connect.Client[types.StringValue, v1.ResticLockList]
+	stats                *connect.Client[types.StringValue, emptypb.Empty]
+	cancel               *connect.Client[types.Int64Value, emptypb.Empty]
+	getLogs              *connect.Client[v1.LogDataRequest, types.BytesValue]
+	clearHistory         *connect.Client[v1.ClearHistoryRequest, emptypb.Empty]
+	runGarbageCollection *connect.Client[emptypb.Empty, v1.GarbageCollectionResult]
+	getPlanMetrics       *connect.Client[v1.GetPlanMetricsRequest, v1.PlanMetrics]
+	testHook             *connect.Client[v1.TestHookRequest, v1.TestHookResponse]
+	pathAutocomplete     *connect.Client[types.StringValue, types.StringList]
 }
 
 // GetConfig calls v1.Backrest.GetConfig.
@@ -291,7 +368,7 @@ func (c *backrestClient) AddRepo(ctx context.Context, req *connect.Request[v1.Re
 }
 
 // GetOperationEvents calls v1.Backrest.GetOperationEvents.
-func (c *backrestClient) GetOperationEvents(ctx context.Context, req *connect.Request[emptypb.Empty]) (*connect.ServerStreamForClient[v1.OperationEvent], error) {
+func (c *backrestClient) GetOperationEvents(ctx context.Context, req *connect.Request[v1.GetOperationEventsRequest]) (*connect.ServerStreamForClient[v1.OperationEvent], error) {
 	return c.getOperationEvents.CallServerStream(ctx, req)
 }
 
@@ -300,6 +377,16 @@ func (c *backrestClient) GetOperations(ctx context.Context, req *connect.Request
 	return c.getOperations.CallUnary(ctx, req)
 }
 
+// ExportOperations calls v1.Backrest.ExportOperations.
+func (c *backrestClient) ExportOperations(ctx context.Context, req *connect.Request[v1.ExportOperationsRequest]) (*connect.ServerStreamForClient[v1.ExportedOperation], error) {
+	return c.exportOperations.CallServerStream(ctx, req)
+}
+
+// ImportOperations calls v1.Backrest.ImportOperations.
+func (c *backrestClient) ImportOperations(ctx context.Context) *connect.ClientStreamForClient[v1.ImportOperationsRequest, types.Int64Value] {
+	return c.importOperations.CallClientStream(ctx)
+}
+
 // ListSnapshots calls v1.Backrest.ListSnapshots.
 func (c *backrestClient) ListSnapshots(ctx context.Context, req *connect.Request[v1.ListSnapshotsRequest]) (*connect.Response[v1.ResticSnapshotList], error) {
 	return c.listSnapshots.CallUnary(ctx, req)
@@ -340,6 +427,11 @@ func (c *backrestClient) Unlock(ctx context.Context, req *connect.Request[types.
 	return c.unlock.CallUnary(ctx, req)
 }
 
+// GetRepoLocks calls v1.Backrest.GetRepoLocks.
+func (c *backrestClient) GetRepoLocks(ctx context.Context, req *connect.Request[types.StringValue]) (*connect.Response[v1.ResticLockList], error) {
+	return c.getRepoLocks.CallUnary(ctx, req)
+}
+
 // Stats calls v1.Backrest.Stats.
 func (c *backrestClient) Stats(ctx context.Context, req *connect.Request[types.StringValue]) (*connect.Response[emptypb.Empty], error) {
 	return c.stats.CallUnary(ctx, req)
@@ -360,6 +452,21 @@ func (c *backrestClient) ClearHistory(ctx context.Context, req *connect.Request[
 	return c.clearHistory.CallUnary(ctx, req)
 }
 
+// RunGarbageCollection calls v1.Backrest.RunGarbageCollection.
+func (c *backrestClient) RunGarbageCollection(ctx context.Context, req *connect.Request[emptypb.Empty]) (*connect.Response[v1.GarbageCollectionResult], error) {
+	return c.runGarbageCollection.CallUnary(ctx, req)
+}
+
+// GetPlanMetrics calls v1.Backrest.GetPlanMetrics.
+func (c *backrestClient) GetPlanMetrics(ctx context.Context, req *connect.Request[v1.GetPlanMetricsRequest]) (*connect.Response[v1.PlanMetrics], error) {
+	return c.getPlanMetrics.CallUnary(ctx, req)
+}
+
+// TestHook calls v1.Backrest.TestHook.
+func (c *backrestClient) TestHook(ctx context.Context, req *connect.Request[v1.TestHookRequest]) (*connect.Response[v1.TestHookResponse], error) {
+	return c.testHook.CallUnary(ctx, req)
+}
+
 // PathAutocomplete calls v1.Backrest.PathAutocomplete.
 func (c *backrestClient) PathAutocomplete(ctx context.Context, req *connect.Request[types.StringValue]) (*connect.Response[types.StringList], error) {
 	return c.pathAutocomplete.CallUnary(ctx, req)
@@ -370,8 +477,13 @@ type BackrestHandler interface {
 	GetConfig(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.Config], error)
 	SetConfig(context.Context, *connect.Request[v1.Config]) (*connect.Response[v1.Config], error)
 	AddRepo(context.Context, *connect.Request[v1.Repo]) (*connect.Response[v1.Config], error)
-	GetOperationEvents(context.Context, *connect.Request[emptypb.Empty], *connect.ServerStream[v1.OperationEvent]) error
+	// GetOperationEvents streams changes to the operation log. If since_seq is set the changes after it are replayed first.
+	GetOperationEvents(context.Context, *connect.Request[v1.GetOperationEventsRequest], *connect.ServerStream[v1.OperationEvent]) error
 	GetOperations(context.Context, *connect.Request[v1.GetOperationsRequest]) (*connect.Response[v1.OperationList], error)
+	// ExportOperations streams the operations matching the request ordered by id, in the format of an operation log export.
+	ExportOperations(context.Context, *connect.Request[v1.ExportOperationsRequest], *connect.ServerStream[v1.ExportedOperation]) error
+	// ImportOperations adds the streamed operations to the log with new ids and returns the number of operations imported.
+	ImportOperations(context.Context, *connect.ClientStream[v1.ImportOperationsRequest]) (*connect.Response[types.Int64Value], error)
 	ListSnapshots(context.Context, *connect.Request[v1.ListSnapshotsRequest]) (*connect.Response[v1.ResticSnapshotList], error)
 	ListSnapshotFiles(context.Context, *connect.Request[v1.ListSnapshotFilesRequest]) (*connect.Response[v1.ListSnapshotFilesResponse], error)
 	// IndexSnapshots triggers indexin. It accepts a repo id and returns empty if the task is enqueued.
@@ -386,6 +498,8 @@ type BackrestHandler interface {
 	Restore(context.Context, *connect.Request[v1.RestoreSnapshotRequest]) (*connect.Response[emptypb.Empty], error)
 	// Unlock synchronously attempts to unlock the repo. Will block if other operations are in progress.
 	Unlock(context.Context, *connect.Request[types.StringValue]) (*connect.Response[emptypb.Empty], error)
+	// GetRepoLocks lists the locks held on the repo and who holds them. It accepts a repo id.
+	GetRepoLocks(context.Context, *connect.Request[types.StringValue]) (*connect.Response[v1.ResticLockList], error)
 	// Stats runs 'restic stats` on the repository and appends the results to the operations log.
 	Stats(context.Context, *connect.Request[types.StringValue]) (*connect.Response[emptypb.Empty], error)
 	// Cancel attempts to cancel a task with the given operation ID. Not guaranteed to succeed.
@@ -394,6 +508,13 @@ type BackrestHandler interface {
 	GetLogs(context.Context, *connect.Request[v1.LogDataRequest]) (*connect.Response[types.BytesValue], error)
 	// Clears the history of operations
 	ClearHistory(context.Context, *connect.Request[v1.ClearHistoryRequest]) (*connect.Response[emptypb.Empty], error)
+	// RunGarbageCollection removes old operations from the history according to the gc policy and returns what was removed.
+	RunGarbageCollection(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.GarbageCollectionResult], error)
+	// GetPlanMetrics returns the plan's backup metrics rolled up into daily or weekly buckets.
+	GetPlanMetrics(context.Context, *connect.Request[v1.GetPlanMetricsRequest]) (*connect.Response[v1.PlanMetrics], error)
+	// TestHook runs a hook once for a condition with variables built from the plan's most recent operations and returns what it
+	// sent. The run is not recorded in the operation history.
+	TestHook(context.Context, *connect.Request[v1.TestHookRequest]) (*connect.Response[v1.TestHookResponse], error)
 	// PathAutocomplete provides path autocompletion options for a given filesystem path.
 	PathAutocomplete(context.Context, *connect.Request[types.StringValue]) (*connect.Response[types.StringList], error)
 }
@@ -434,6 +555,18 @@ func NewBackrestHandler(svc BackrestHandler, opts ...connect.HandlerOption) (str
 		connect.WithSchema(backrestGetOperationsMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	backrestExportOperationsHandler := connect.NewServerStreamHandler(
+		BackrestExportOperationsProcedure,
+		svc.ExportOperations,
+		connect.WithSchema(backrestExportOperationsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	backrestImportOperationsHandler := connect.NewClientStreamHandler(
+		BackrestImportOperationsProcedure,
+		svc.ImportOperations,
+		connect.WithSchema(backrestImportOperationsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	backrestListSnapshotsHandler := connect.NewUnaryHandler(
 		BackrestListSnapshotsProcedure,
 		svc.ListSnapshots,
@@ -482,6 +615,12 @@ func NewBackrestHandler(svc BackrestHandler, opts ...connect.HandlerOption) (str
 		connect.WithSchema(backrestUnlockMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	backrestGetRepoLocksHandler := connect.NewUnaryHandler(
+		BackrestGetRepoLocksProcedure,
+		svc.GetRepoLocks,
+		connect.WithSchema(backrestGetRepoLocksMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	backrestStatsHandler := connect.NewUnaryHandler(
 		BackrestStatsProcedure,
 		svc.Stats,
@@ -506,6 +645,24 @@ func NewBackrestHandler(svc BackrestHandler, opts ...connect.HandlerOption) (str
 		connect.WithSchema(backrestClearHistoryMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	backrestRunGarbageCollectionHandler := connect.NewUnaryHandler(
+		BackrestRunGarbageCollectionProcedure,
+		svc.RunGarbageCollection,
+		connect.WithSchema(backrestRunGarbageCollectionMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	backrestGetPlanMetricsHandler := connect.NewUnaryHandler(
+		BackrestGetPlanMetricsProcedure,
+		svc.GetPlanMetrics,
+		connect.WithSchema(backrestGetPlanMetricsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	backrestTestHookHandler := connect.NewUnaryHandler(
+		BackrestTestHookProcedure,
+		svc.TestHook,
+		connect.WithSchema(backrestTestHookMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	backrestPathAutocompleteHandler := connect.NewUnaryHandler(
 		BackrestPathAutocompleteProcedure,
 		svc.PathAutocomplete,
@@ -524,6 +681,10 @@ func NewBackrestHandler(svc BackrestHandler, opts ...connect.HandlerOption) (str
 			backrestGetOperationEventsHandler.ServeHTTP(w, r)
 		case BackrestGetOperationsProcedure:
 			backrestGetOperationsHandler.ServeHTTP(w, r)
+		case BackrestExportOperationsProcedure:
+			backrestExportOperationsHandler.ServeHTTP(w, r)
+		case BackrestImportOperationsProcedure:
+			backrestImportOperationsHandler.ServeHTTP(w, r)
 		case BackrestListSnapshotsProcedure:
 			backrestListSnapshotsHandler.ServeHTTP(w, r)
 		case BackrestListSnapshotFilesProcedure:
@@ -540,6 +701,8 @@ func NewBackrestHandler(svc BackrestHandler, opts ...connect.HandlerOption) (str
 			backrestRestoreHandler.ServeHTTP(w, r)
 		case BackrestUnlockProcedure:
 			backrestUnlockHandler.ServeHTTP(w, r)
+		case BackrestGetRepoLocksProcedure:
+			backrestGetRepoLocksHandler.ServeHTTP(w, r)
 		case BackrestStatsProcedure:
 			backrestStatsHandler.ServeHTTP(w, r)
 		case BackrestCancelProcedure:
@@ -548,6 +711,12 @@ func NewBackrestHandler(svc BackrestHandler, opts ...connect.HandlerOption) (str
 			backrestGetLogsHandler.ServeHTTP(w, r)
 		case BackrestClearHistoryProcedure:
 			backrestClearHistoryHandler.ServeHTTP(w, r)
+		case BackrestRunGarbageCollectionProcedure:
+			backrestRunGarbageCollectionHandler.ServeHTTP(w, r)
+		case BackrestGetPlanMetricsProcedure:
+			backrestGetPlanMetricsHandler.ServeHTTP(w, r)
+		case BackrestTestHookProcedure:
+			backrestTestHookHandler.ServeHTTP(w, r)
 		case BackrestPathAutocompleteProcedure:
 			backrestPathAutocompleteHandler.ServeHTTP(w, r)
 		default:
@@ -571,7 +740,7 @@ func (UnimplementedBackrestHandler) AddRepo(context.Context, *connect.Request[v1
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1.Backrest.AddRepo is not implemented"))
 }
 
-func (UnimplementedBackrestHandler) GetOperationEvents(context.Context, *connect.Request[emptypb.Empty], *connect.ServerStream[v1.OperationEvent]) error {
+func (UnimplementedBackrestHandler) GetOperationEvents(context.Context, *connect.Request[v1.GetOperationEventsRequest], *connect.ServerStream[v1.OperationEvent]) error {
 	return connect.NewError(connect.CodeUnimplemented, errors.New("v1.Backrest.GetOperationEvents is not implemented"))
 }
 
@@ -579,6 +748,14 @@ func (UnimplementedBackrestHandler) GetOperations(context.Context, *connect.Requ
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1.Backrest.GetOperations is not implemented"))
 }
 
+func (UnimplementedBackrestHandler) ExportOperations(context.Context, *connect.Request[v1.ExportOperationsRequest], *connect.ServerStream[v1.ExportedOperation]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("v1.Backrest.ExportOperations is not implemented"))
+}
+
+func (UnimplementedBackrestHandler) ImportOperations(context.Context, *connect.ClientStream[v1.ImportOperationsRequest]) (*connect.Response[types.Int64Value], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1.Backrest.ImportOperations is not implemented"))
+}
+
 func (UnimplementedBackrestHandler) ListSnapshots(context.Context, *connect.Request[v1.ListSnapshotsRequest]) (*connect.Response[v1.ResticSnapshotList], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1.Backrest.ListSnapshots is not implemented"))
 }
@@ -611,6 +788,10 @@ func (UnimplementedBackrestHandler) Unlock(context.Context, *connect.Request[typ
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1.Backrest.Unlock is not implemented"))
 }
 
+func (UnimplementedBackrestHandler) GetRepoLocks(context.Context, *connect.Request[types.StringValue]) (*connect.Response[v1.ResticLockList], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1.Backrest.GetRepoLocks is not implemented"))
+}
+
 func (UnimplementedBackrestHandler) Stats(context.Context, *connect.Request[types.StringValue]) (*connect.Response[emptypb.Empty], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1.Backrest.Stats is not implemented"))
 }
@@ -627,6 +808,18 @@ func (UnimplementedBackrestHandler) ClearHistory(context.Context, *connect.Reque
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1.Backrest.ClearHistory is not implemented"))
 }
 
+func (UnimplementedBackrestHandler) RunGarbageCollection(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.GarbageCollectionResult], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1.Backrest.RunGarbageCollection is not implemented"))
+}
+
+func (UnimplementedBackrestHandler) GetPlanMetrics(context.Context, *connect.Request[v1.GetPlanMetricsRequest]) (*connect.Response[v1.PlanMetrics], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1.Backrest.GetPlanMetrics is not implemented"))
+}
+
+func (UnimplementedBackrestHandler) TestHook(context.Context, *connect.Request[v1.TestHookRequest]) (*connect.Response[v1.TestHookResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1.Backrest.TestHook is not implemented"))
+}
+
 func (UnimplementedBackrestHandler) PathAutocomplete(context.Context, *connect.Request[types.StringValue]) (*connect.Response[types.StringList], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1.Backrest.PathAutocomplete is not implemented"))
 }
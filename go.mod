@@ -10,7 +10,9 @@ require (
 	github.com/golang-jwt/jwt/v5 v5.2.1
 	github.com/google/shlex v0.0.0-20191202100458-e7afc7fbc510
 	github.com/hashicorp/go-multierror v1.1.1
+	github.com/klauspost/compress v1.17.7
 	github.com/mattn/go-colorable v0.1.13
+	github.com/mattn/go-sqlite3 v1.14.22
 	github.com/natefinch/atomic v1.0.1
 	go.etcd.io/bbolt v1.3.9
 	go.uber.org/zap v1.27.0
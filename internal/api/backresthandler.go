@@ -4,8 +4,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"os"
 	"path"
+	"slices"
 	"sync"
 	"time"
 
@@ -14,8 +16,8 @@ import (
 	v1 "github.com/garethgeorge/backrest/gen/go/v1"
 	"github.com/garethgeorge/backrest/gen/go/v1/v1connect"
 	"github.com/garethgeorge/backrest/internal/config"
+	"github.com/garethgeorge/backrest/internal/metrics"
 	"github.com/garethgeorge/backrest/internal/oplog"
-	"github.com/garethgeorge/backrest/internal/oplog/indexutil"
 	"github.com/garethgeorge/backrest/internal/orchestrator"
 	"github.com/garethgeorge/backrest/internal/protoutil"
 	"github.com/garethgeorge/backrest/internal/resticinstaller"
@@ -30,18 +32,23 @@ type BackrestHandler struct {
 	v1connect.UnimplementedBackrestHandler
 	config       config.ConfigStore
 	orchestrator *orchestrator.Orchestrator
-	oplog        *oplog.OpLog
+	oplog        oplog.OpLog
 	logStore     *rotatinglog.RotatingLog
+	metrics      *metrics.Store
 }
 
 var _ v1connect.BackrestHandler = &BackrestHandler{}
 
-func NewBackrestHandler(config config.ConfigStore, orchestrator *orchestrator.Orchestrator, oplog *oplog.OpLog, logStore *rotatinglog.RotatingLog) *BackrestHandler {
+// operationEventBufferSize is the number of events buffered for a GetOperationEvents client before its overflow policy applies.
+const operationEventBufferSize = 256
+
+func NewBackrestHandler(config config.ConfigStore, orchestrator *orchestrator.Orchestrator, oplog oplog.OpLog, logStore *rotatinglog.RotatingLog, metrics *metrics.Store) *BackrestHandler {
 	s := &BackrestHandler{
 		config:       config,
 		orchestrator: orchestrator,
 		oplog:        oplog,
 		logStore:     logStore,
+		metrics:      metrics,
 	}
 
 	return s
@@ -187,68 +194,65 @@ func (s *BackrestHandler) ListSnapshotFiles(ctx context.Context, req *connect.Re
 }
 
 // GetOperationEvents implements GET /v1/events/operations
-func (s *BackrestHandler) GetOperationEvents(ctx context.Context, req *connect.Request[emptypb.Empty], resp *connect.ServerStream[v1.OperationEvent]) error {
-	errorChan := make(chan error)
-	defer close(errorChan)
-	callback := func(oldOp *v1.Operation, newOp *v1.Operation) {
-		var event *v1.OperationEvent
-		if oldOp == nil && newOp != nil {
-			event = &v1.OperationEvent{
-				Type:      v1.OperationEventType_EVENT_CREATED,
-				Operation: newOp,
-			}
-		} else if oldOp != nil && newOp != nil {
-			event = &v1.OperationEvent{
-				Type:      v1.OperationEventType_EVENT_UPDATED,
-				Operation: newOp,
-			}
-		} else if oldOp != nil && newOp == nil {
-			event = &v1.OperationEvent{
-				Type:      v1.OperationEventType_EVENT_DELETED,
-				Operation: oldOp,
+func (s *BackrestHandler) GetOperationEvents(ctx context.Context, req *connect.Request[v1.GetOperationEventsRequest], resp *connect.ServerStream[v1.OperationEvent]) error {
+	policy := oplog.OverflowDisconnect
+	if req.Msg.ResyncOnOverflow {
+		policy = oplog.OverflowResync
+	}
+	// subscribe before replaying so that no event falls between the replay and the live events.
+	sub := s.oplog.Subscribe(operationEventBufferSize, policy)
+	defer sub.Close()
+
+	lastSeq := req.Msg.SinceSeq
+	send := func(event *v1.OperationEvent) error {
+		if !event.Transient {
+			if event.Seq <= lastSeq && event.Type != v1.OperationEventType_EVENT_RESYNC_REQUIRED {
+				return nil // already replayed.
 			}
-		} else {
-			zap.L().Error("Unknown event type")
-			return
+			lastSeq = event.Seq
 		}
-
 		if err := resp.Send(event); err != nil {
-			errorChan <- fmt.Errorf("failed to send event: %w", err)
+			return fmt.Errorf("failed to send event: %w", err)
 		}
-	}
-	s.oplog.Subscribe(&callback)
-	defer s.oplog.Unsubscribe(&callback)
-	select {
-	case <-ctx.Done():
 		return nil
-	case err := <-errorChan:
-		return err
 	}
-}
 
-func (s *BackrestHandler) GetOperations(ctx context.Context, req *connect.Request[v1.GetOperationsRequest]) (*connect.Response[v1.OperationList], error) {
-	idCollector := indexutil.CollectAll()
+	if req.Msg.SinceSeq != 0 {
+		events, seq, err := s.oplog.EventsSince(req.Msg.SinceSeq)
+		if errors.Is(err, oplog.ErrResyncRequired) {
+			events = []*v1.OperationEvent{{Type: v1.OperationEventType_EVENT_RESYNC_REQUIRED, Seq: seq}}
+		} else if err != nil {
+			return fmt.Errorf("failed to replay events since %d: %w", req.Msg.SinceSeq, err)
+		}
+		for _, event := range events {
+			if err := send(event); err != nil {
+				return err
+			}
+		}
+	}
 
-	if req.Msg.LastN != 0 {
-		idCollector = indexutil.CollectLastN(int(req.Msg.LastN))
+	for {
+		event, err := sub.Next(ctx)
+		if ctx.Err() != nil {
+			return nil
+		} else if err != nil {
+			return fmt.Errorf("operation events: %w", err)
+		}
+		if err := send(event); err != nil {
+			return err
+		}
 	}
+}
 
-	var err error
-	var ops []*v1.Operation
-	opCollector := func(op *v1.Operation) error {
-		ops = append(ops, op)
-		return nil
+func (s *BackrestHandler) GetOperations(ctx context.Context, req *connect.Request[v1.GetOperationsRequest]) (*connect.Response[v1.OperationList], error) {
+	// read before the operations so that replaying the events since seq can't miss a change to them.
+	seq, err := s.oplog.CurrentSeq()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get event seq: %w", err)
 	}
-	if req.Msg.RepoId != "" && req.Msg.PlanId != "" {
-		return nil, errors.New("cannot specify both repoId and planId")
-	} else if req.Msg.PlanId != "" {
-		err = s.oplog.ForEachByPlan(req.Msg.PlanId, idCollector, opCollector)
-	} else if req.Msg.RepoId != "" {
-		err = s.oplog.ForEachByRepo(req.Msg.RepoId, idCollector, opCollector)
-	} else if req.Msg.SnapshotId != "" {
-		err = s.oplog.ForEachBySnapshotId(req.Msg.SnapshotId, idCollector, opCollector)
-	} else if len(req.Msg.Ids) > 0 {
-		ops = make([]*v1.Operation, 0, len(req.Msg.Ids))
+
+	if len(req.Msg.Ids) > 0 {
+		ops := make([]*v1.Operation, 0, len(req.Msg.Ids))
 		for i, id := range req.Msg.Ids {
 			op, err := s.oplog.Get(id)
 			if err != nil {
@@ -256,18 +260,97 @@ func (s *BackrestHandler) GetOperations(ctx context.Context, req *connect.Reques
 			}
 			ops = append(ops, op)
 		}
-	} else {
-		err = s.oplog.ForAll(opCollector)
+		return connect.NewResponse(&v1.OperationList{
+			Operations: ops,
+			Seq:        seq,
+		}), nil
+	}
+
+	query := oplog.Query{
+		RepoId:      req.Msg.RepoId,
+		PlanId:      req.Msg.PlanId,
+		SnapshotId:  req.Msg.SnapshotId,
+		Statuses:    req.Msg.Statuses,
+		Types:       req.Msg.Types,
+		StartTimeMs: req.Msg.StartTimeMs,
+		EndTimeMs:   req.Msg.EndTimeMs,
+		Search:      req.Msg.Search,
+		Cursor:      req.Msg.Cursor,
+		Limit:       int(req.Msg.Limit),
+		Reverse:     req.Msg.Reverse,
 	}
+
+	// last_n selects the newest operations but returns them oldest first.
+	lastN := req.Msg.LastN != 0 && req.Msg.Limit == 0
+	if lastN {
+		query.Limit = int(req.Msg.LastN)
+		query.Reverse = true
+	}
+
+	var ops []*v1.Operation
+	nextCursor, err := s.oplog.Query(query, func(op *v1.Operation) error {
+		ops = append(ops, op)
+		return nil
+	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to get operations: %w", err)
 	}
 
+	if lastN {
+		slices.Reverse(ops)
+		nextCursor = 0
+	}
+
 	return connect.NewResponse(&v1.OperationList{
 		Operations: ops,
+		NextCursor: nextCursor,
+		Seq:        seq,
 	}), nil
 }
 
+func (s *BackrestHandler) ExportOperations(ctx context.Context, req *connect.Request[v1.ExportOperationsRequest], resp *connect.ServerStream[v1.ExportedOperation]) error {
+	query := oplog.Query{
+		RepoId:      req.Msg.RepoId,
+		PlanId:      req.Msg.PlanId,
+		StartTimeMs: req.Msg.StartTimeMs,
+		EndTimeMs:   req.Msg.EndTimeMs,
+	}
+	var logs *rotatinglog.RotatingLog
+	if req.Msg.IncludeLogs {
+		logs = s.logStore
+	}
+
+	if err := oplog.Export(s.oplog, query, logs, func(op *v1.ExportedOperation) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		return resp.Send(op)
+	}); err != nil {
+		return fmt.Errorf("failed to export operations: %w", err)
+	}
+	return nil
+}
+
+func (s *BackrestHandler) ImportOperations(ctx context.Context, req *connect.ClientStream[v1.ImportOperationsRequest]) (*connect.Response[types.Int64Value], error) {
+	count, err := oplog.Import(s.oplog, func() (*v1.ExportedOperation, error) {
+		if !req.Receive() {
+			if err := req.Err(); err != nil {
+				return nil, err
+			}
+			return nil, io.EOF
+		}
+		exported := req.Msg().GetOperation()
+		if exported != nil && !req.Msg().RelinkLogs {
+			exported.Logs = nil // without logs to store the operation keeps its logrefs.
+		}
+		return exported, nil
+	}, s.logStore)
+	if err != nil {
+		return nil, fmt.Errorf("failed to import operations, imported %d before the failure: %w", count, err)
+	}
+	return connect.NewResponse(&types.Int64Value{Value: int64(count)}), nil
+}
+
 func (s *BackrestHandler) IndexSnapshots(ctx context.Context, req *connect.Request[types.StringValue]) (*connect.Response[emptypb.Empty], error) {
 	_, err := s.orchestrator.GetRepo(req.Msg.Value)
 	if err != nil {
@@ -388,6 +471,20 @@ func (s *BackrestHandler) Unlock(ctx context.Context, req *connect.Request[types
 	return connect.NewResponse(&emptypb.Empty{}), nil
 }
 
+func (s *BackrestHandler) GetRepoLocks(ctx context.Context, req *connect.Request[types.StringValue]) (*connect.Response[v1.ResticLockList], error) {
+	repo, err := s.orchestrator.GetRepo(req.Msg.Value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get repo %q: %w", req.Msg.Value, err)
+	}
+
+	locks, err := repo.Locks(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get locks for repo %q: %w", req.Msg.Value, err)
+	}
+
+	return connect.NewResponse(&v1.ResticLockList{Locks: locks}), nil
+}
+
 func (s *BackrestHandler) Stats(ctx context.Context, req *connect.Request[types.StringValue]) (*connect.Response[emptypb.Empty], error) {
 	at := time.Now()
 	var err error
@@ -418,19 +515,18 @@ func (s *BackrestHandler) ClearHistory(ctx context.Context, req *connect.Request
 		ids = append(ids, req.Msg.Ops...)
 	}
 
-	opCollector := func(op *v1.Operation) error {
-		if !req.Msg.OnlyFailed || op.Status == v1.OperationStatus_STATUS_ERROR {
-			ids = append(ids, op.Id)
+	if req.Msg.RepoId != "" || req.Msg.PlanId != "" {
+		query := oplog.Query{
+			RepoId: req.Msg.RepoId,
+			PlanId: req.Msg.PlanId,
 		}
-		return nil
-	}
-
-	if req.Msg.RepoId != "" && req.Msg.PlanId != "" {
-		return nil, errors.New("cannot specify both repoId and planId")
-	} else if req.Msg.PlanId != "" {
-		err = s.oplog.ForEachByPlan(req.Msg.PlanId, indexutil.CollectAll(), opCollector)
-	} else if req.Msg.RepoId != "" {
-		err = s.oplog.ForEachByRepo(req.Msg.RepoId, indexutil.CollectAll(), opCollector)
+		if req.Msg.OnlyFailed {
+			query.Statuses = []v1.OperationStatus{v1.OperationStatus_STATUS_ERROR}
+		}
+		_, err = s.oplog.Query(query, func(op *v1.Operation) error {
+			ids = append(ids, op.Id)
+			return nil
+		})
 	}
 
 	if err != nil {
@@ -458,6 +554,33 @@ func (s *BackrestHandler) GetLogs(ctx context.Context, req *connect.Request[v1.L
 	return connect.NewResponse(&types.BytesValue{Value: data}), nil
 }
 
+func (s *BackrestHandler) RunGarbageCollection(ctx context.Context, req *connect.Request[emptypb.Empty]) (*connect.Response[v1.GarbageCollectionResult], error) {
+	result, err := s.orchestrator.RunGarbageCollection(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to run garbage collection: %w", err)
+	}
+	return connect.NewResponse(result), nil
+}
+
+func (s *BackrestHandler) GetPlanMetrics(ctx context.Context, req *connect.Request[v1.GetPlanMetricsRequest]) (*connect.Response[v1.PlanMetrics], error) {
+	if req.Msg.PlanId == "" {
+		return nil, errors.New("plan_id is required")
+	}
+	metrics, err := s.metrics.PlanMetrics(req.Msg.PlanId, req.Msg.BucketSize, req.Msg.StartTimeMs, req.Msg.EndTimeMs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get metrics for plan %q: %w", req.Msg.PlanId, err)
+	}
+	return connect.NewResponse(metrics), nil
+}
+
+func (s *BackrestHandler) TestHook(ctx context.Context, req *connect.Request[v1.TestHookRequest]) (*connect.Response[v1.TestHookResponse], error) {
+	resp, err := s.orchestrator.TestHook(ctx, req.Msg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to test hook: %w", err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
 func (s *BackrestHandler) PathAutocomplete(ctx context.Context, path *connect.Request[types.StringValue]) (*connect.Response[types.StringList], error) {
 	ents, err := os.ReadDir(path.Msg.Value)
 	if errors.Is(err, os.ErrNotExist) {
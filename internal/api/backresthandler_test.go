@@ -18,6 +18,7 @@ import (
 	"github.com/garethgeorge/backrest/gen/go/types"
 	v1 "github.com/garethgeorge/backrest/gen/go/v1"
 	"github.com/garethgeorge/backrest/internal/config"
+	"github.com/garethgeorge/backrest/internal/metrics"
 	"github.com/garethgeorge/backrest/internal/oplog"
 	"github.com/garethgeorge/backrest/internal/orchestrator"
 	"github.com/garethgeorge/backrest/internal/resticinstaller"
@@ -85,6 +86,35 @@ func TestUpdateConfig(t *testing.T) {
 	}
 }
 
+func TestGetOperationsSeq(t *testing.T) {
+	t.Parallel()
+
+	sut := createSystemUnderTest(t, &config.MemoryStore{
+		Config: &v1.Config{
+			Modno: 1234,
+		},
+	})
+
+	op := &v1.Operation{RepoId: "repo1", PlanId: "plan1", UnixTimeStartMs: 1000, Status: v1.OperationStatus_STATUS_SUCCESS, Op: &v1.Operation_OperationBackup{}}
+	if err := sut.oplog.Add(op); err != nil {
+		t.Fatalf("Failed to add operation: %v", err)
+	}
+	seq, err := sut.oplog.CurrentSeq()
+	if err != nil {
+		t.Fatalf("Failed to get event seq: %v", err)
+	}
+
+	for _, req := range []*v1.GetOperationsRequest{{RepoId: "repo1"}, {Ids: []int64{op.Id}}} {
+		res, err := sut.handler.GetOperations(context.Background(), connect.NewRequest(req))
+		if err != nil {
+			t.Fatalf("GetOperations(%v) error: %v", req, err)
+		}
+		if len(res.Msg.Operations) != 1 || res.Msg.Seq != seq {
+			t.Errorf("GetOperations(%v) got %d operations at seq %d, want 1 at seq %d", req, len(res.Msg.Operations), res.Msg.Seq, seq)
+		}
+	}
+}
+
 func TestBackup(t *testing.T) {
 	t.Parallel()
 
@@ -527,7 +557,7 @@ func TestRestore(t *testing.T) {
 
 type systemUnderTest struct {
 	handler  *BackrestHandler
-	oplog    *oplog.OpLog
+	oplog    oplog.OpLog
 	orch     *orchestrator.Orchestrator
 	logStore *rotatinglog.RotatingLog
 	config   *v1.Config
@@ -545,7 +575,7 @@ func createSystemUnderTest(t *testing.T, config config.ConfigStore) systemUnderT
 	if err != nil {
 		t.Fatalf("Failed to find or install restic binary: %v", err)
 	}
-	oplog, err := oplog.NewOpLog(dir + "/oplog.boltdb")
+	oplog, err := oplog.NewBoltStore(dir + "/oplog.boltdb")
 	if err != nil {
 		t.Fatalf("Failed to create oplog: %v", err)
 	}
@@ -560,7 +590,15 @@ func createSystemUnderTest(t *testing.T, config config.ConfigStore) systemUnderT
 		t.Fatalf("Failed to create orchestrator: %v", err)
 	}
 
-	h := NewBackrestHandler(config, orch, oplog, logStore)
+	metricsStore, err := metrics.NewStore(dir+"/metrics.boltdb", oplog)
+	if err != nil {
+		t.Fatalf("Failed to create metrics store: %v", err)
+	}
+	t.Cleanup(func() {
+		metricsStore.Close()
+	})
+
+	h := NewBackrestHandler(config, orch, oplog, logStore, metricsStore)
 
 	return systemUnderTest{
 		handler:  h,
@@ -584,10 +622,10 @@ func retry(t *testing.T, times int, backoff time.Duration, f func() error) error
 	return err
 }
 
-func getOperations(t *testing.T, oplog *oplog.OpLog) []*v1.Operation {
+func getOperations(t *testing.T, log oplog.OpLog) []*v1.Operation {
 	t.Logf("Reading oplog")
 	operations := []*v1.Operation{}
-	if err := oplog.ForAll(func(op *v1.Operation) error {
+	if _, err := log.Query(oplog.Query{}, func(op *v1.Operation) error {
 		operations = append(operations, op)
 		t.Logf("operation %t status %s", op.GetOp(), op.Status)
 		return nil
@@ -14,6 +14,9 @@ import (
 
 var ErrConfigNotFound = fmt.Errorf("config not found")
 
+// PlanForSelfBackup is the reserved plan id of the backups of backrest's own state, see v1.SelfBackup.
+const PlanForSelfBackup = "_self_backup_"
+
 type ConfigStore interface {
 	Get() (*v1.Config, error)
 	Update(config *v1.Config) error
@@ -84,9 +84,70 @@ func TestConfig(t *testing.T) {
 			wantErr:         true,
 			wantErrContains: "invalid cron \"bad cron\"",
 		},
+		{
+			name: "plan with bad webhook url",
+			config: &v1.Config{
+				Repos: []*v1.Repo{
+					testRepo,
+				},
+				Plans: []*v1.Plan{
+					{
+						Id:    "test-plan",
+						Repo:  "test-repo",
+						Paths: []string{"/tmp/foo"},
+						Cron:  "* * * * *",
+						Hooks: []*v1.Hook{
+							{
+								Conditions: []v1.Hook_Condition{v1.Hook_CONDITION_SNAPSHOT_END},
+								Action:     &v1.Hook_ActionWebhook{ActionWebhook: &v1.Hook_Webhook{WebhookUrl: "example.com/hook"}},
+							},
+						},
+					},
+				},
+			},
+			store:           &CachingValidatingStore{ConfigStore: &JsonFileStore{Path: dir + "/invalid-config4.json"}},
+			wantErr:         true,
+			wantErrContains: "invalid url \"example.com/hook\"",
+		},
+		{
+			name: "plan skips unknown global hook",
+			config: &v1.Config{
+				Repos: []*v1.Repo{
+					testRepo,
+				},
+				Plans: []*v1.Plan{
+					{
+						Id:              "test-plan",
+						Repo:            "test-repo",
+						Paths:           []string{"/tmp/foo"},
+						Cron:            "* * * * *",
+						SkipGlobalHooks: []string{"slack"},
+					},
+				},
+			},
+			store:           &CachingValidatingStore{ConfigStore: &JsonFileStore{Path: dir + "/invalid-config5.json"}},
+			wantErr:         true,
+			wantErrContains: "no global hook named \"slack\"",
+		},
+		{
+			name: "repo with short stale lock age",
+			config: &v1.Config{
+				Repos: []*v1.Repo{
+					{
+						Id:                  "test-repo",
+						Uri:                 "/tmp/test",
+						StaleLockAgeMinutes: 5,
+					},
+				},
+			},
+			store:           &CachingValidatingStore{ConfigStore: &JsonFileStore{Path: dir + "/invalid-config6.json"}},
+			wantErr:         true,
+			wantErrContains: "stale_lock_age_minutes must be at least 10",
+		},
 	}
 
 	for _, tc := range tests {
+		tc := tc
 		t.Run(tc.name, func(t *testing.T) {
 			t.Parallel()
 			err := tc.store.Update(tc.config)
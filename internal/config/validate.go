@@ -3,6 +3,8 @@ package config
 import (
 	"errors"
 	"fmt"
+	"net/mail"
+	"net/url"
 	"slices"
 	"strings"
 
@@ -11,8 +13,16 @@ import (
 	"github.com/hashicorp/go-multierror"
 )
 
+// minStaleLockAgeMinutes is the youngest age at which a lock can be considered stale. restic refreshes the locks of running
+// commands every 5 minutes, a younger limit would remove locks that are still held.
+const minStaleLockAgeMinutes = 10
+
 func ValidateConfig(c *v1.Config) error {
 	var err error
+	if e := validateGcPolicy(c.GcPolicy); e != nil {
+		err = multierror.Append(err, fmt.Errorf("gc policy: %w", e))
+	}
+
 	repos := make(map[string]*v1.Repo)
 	if c.Repos != nil {
 		for _, repo := range c.Repos {
@@ -32,12 +42,43 @@ func ValidateConfig(c *v1.Config) error {
 		})
 	}
 
+	globalHooks := make(map[string]bool)
+	for _, hook := range c.Hooks {
+		if hook.Name == "" {
+			continue
+		}
+		if globalHooks[hook.Name] {
+			err = multierror.Append(err, fmt.Errorf("hooks: duplicate name %q", hook.Name))
+		}
+		globalHooks[hook.Name] = true
+	}
+	if e := validateHooks(c.Hooks); e != nil {
+		err = multierror.Append(err, fmt.Errorf("hooks: %w", e))
+	}
+	for _, repo := range c.Repos {
+		if e := validateSkipGlobalHooks(repo.SkipGlobalHooks, globalHooks); e != nil {
+			err = multierror.Append(err, fmt.Errorf("repo %s: %w", repo.GetId(), e))
+		}
+	}
+	for _, plan := range c.Plans {
+		if e := validateSkipGlobalHooks(plan.SkipGlobalHooks, globalHooks); e != nil {
+			err = multierror.Append(err, fmt.Errorf("plan %s: %w", plan.GetId(), e))
+		}
+	}
+
+	if e := validateSelfBackup(c.SelfBackup, repos); e != nil {
+		err = multierror.Append(err, fmt.Errorf("self backup: %w", e))
+	}
+
 	if c.Plans != nil {
 		plans := make(map[string]*v1.Plan)
 		for _, plan := range c.Plans {
 			if _, ok := plans[plan.Id]; ok {
 				err = multierror.Append(err, fmt.Errorf("plan %s: duplicate id", plan.GetId()))
 			}
+			if plan.Id == PlanForSelfBackup {
+				err = multierror.Append(err, fmt.Errorf("plan %s: id is reserved for self backups", plan.GetId()))
+			}
 			plans[plan.Id] = plan
 			if e := validatePlan(plan, repos); e != nil {
 				err = multierror.Append(err, fmt.Errorf("plan %s: %w", plan.GetId(), e))
@@ -64,12 +105,20 @@ func validateRepo(repo *v1.Repo) error {
 		err = multierror.Append(err, errors.New("uri is required"))
 	}
 
+	if repo.StaleLockAgeMinutes != 0 && repo.StaleLockAgeMinutes < minStaleLockAgeMinutes {
+		err = multierror.Append(err, fmt.Errorf("stale_lock_age_minutes must be at least %d, or 0 for the default", minStaleLockAgeMinutes))
+	}
+
 	for _, env := range repo.Env {
 		if !strings.Contains(env, "=") {
 			err = multierror.Append(err, fmt.Errorf("invalid env var %s, must take format KEY=VALUE", env))
 		}
 	}
 
+	if e := validateHooks(repo.Hooks); e != nil {
+		err = multierror.Append(err, e)
+	}
+
 	slices.Sort(repo.Env)
 
 	return err
@@ -103,9 +152,131 @@ func validatePlan(plan *v1.Plan, repos map[string]*v1.Repo) error {
 		err = multierror.Append(err, errors.New("retention policy must be nil or must specify a policy"))
 	}
 
+	if e := validateGcPolicy(plan.GcPolicy); e != nil {
+		err = multierror.Append(err, fmt.Errorf("gc policy: %w", e))
+	}
+
+	if e := validateHooks(plan.Hooks); e != nil {
+		err = multierror.Append(err, e)
+	}
+
 	slices.Sort(plan.Paths)
 	slices.Sort(plan.Excludes)
 	slices.Sort(plan.Iexcludes)
 
 	return err
 }
+
+func validateSelfBackup(selfBackup *v1.SelfBackup, repos map[string]*v1.Repo) error {
+	if selfBackup.GetRepo() == "" {
+		return nil
+	}
+
+	var err error
+	if _, ok := repos[selfBackup.Repo]; !ok {
+		err = multierror.Append(err, fmt.Errorf("repo %q not found", selfBackup.Repo))
+	}
+	if _, e := cronexpr.Parse(selfBackup.Cron); e != nil {
+		err = multierror.Append(err, fmt.Errorf("invalid cron %q: %w", selfBackup.Cron, e))
+	}
+	return err
+}
+
+func validateGcPolicy(policy *v1.GcPolicy) error {
+	if policy == nil {
+		return nil
+	}
+
+	var err error
+	if policy.MaxAgeDays < 0 || policy.MaxCount < 0 || policy.FailedMaxAgeDays < 0 || policy.IntervalHours < 0 {
+		err = multierror.Append(err, errors.New("max_age_days, max_count, failed_max_age_days and interval_hours must be non-negative"))
+	}
+	for name, days := range policy.MaxAgeDaysByType {
+		if opType, ok := v1.OperationType_value[name]; !ok || opType == int32(v1.OperationType_TYPE_UNKNOWN) {
+			err = multierror.Append(err, fmt.Errorf("max_age_days_by_type: unknown operation type %q", name))
+		}
+		if days <= 0 {
+			err = multierror.Append(err, fmt.Errorf("max_age_days_by_type: max age of %q must be positive", name))
+		}
+	}
+	return err
+}
+
+func validateHooks(hooks []*v1.Hook) error {
+	var err error
+	for idx, hook := range hooks {
+		if hook.TimeoutSeconds < 0 || hook.Retries < 0 || hook.RetryBackoffSeconds < 0 {
+			err = multierror.Append(err, fmt.Errorf("hook %d: timeout_seconds, retries and retry_backoff_seconds must be non-negative", idx))
+		}
+		if policy := hook.NotificationPolicy; policy != nil && (policy.MaxPerPeriod < 0 || policy.PeriodMinutes < 0) {
+			err = multierror.Append(err, fmt.Errorf("hook %d: notification_policy: max_per_period and period_minutes must be non-negative", idx))
+		}
+		for k := range hook.GetActionCommand().GetEnv() {
+			if k == "" || strings.ContainsAny(k, "=\x00") {
+				err = multierror.Append(err, fmt.Errorf("hook %d: command: invalid env var name %q", idx, k))
+			}
+		}
+		if webhook := hook.GetActionWebhook(); webhook != nil {
+			if e := validateWebhook(webhook); e != nil {
+				err = multierror.Append(err, fmt.Errorf("hook %d: webhook: %w", idx, e))
+			}
+		}
+		if email := hook.GetActionEmail(); email != nil {
+			if e := validateEmail(email); e != nil {
+				err = multierror.Append(err, fmt.Errorf("hook %d: email: %w", idx, e))
+			}
+		}
+		if check := hook.GetActionHealthcheck(); check != nil {
+			if u, e := url.Parse(check.PingUrl); e != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+				err = multierror.Append(err, fmt.Errorf("hook %d: healthcheck: invalid ping url %q, must be an absolute http or https url", idx, check.PingUrl))
+			}
+			if check.MaxBodyBytes < 0 {
+				err = multierror.Append(err, fmt.Errorf("hook %d: healthcheck: max_body_bytes must be non-negative", idx))
+			}
+		}
+	}
+	return err
+}
+
+func validateSkipGlobalHooks(names []string, globalHooks map[string]bool) error {
+	var err error
+	for _, name := range names {
+		if !globalHooks[name] {
+			err = multierror.Append(err, fmt.Errorf("skip_global_hooks: no global hook named %q", name))
+		}
+	}
+	return err
+}
+
+func validateWebhook(webhook *v1.Hook_Webhook) error {
+	var err error
+	if u, e := url.Parse(webhook.WebhookUrl); e != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		err = multierror.Append(err, fmt.Errorf("invalid url %q, must be an absolute http or https url", webhook.WebhookUrl))
+	}
+	if webhook.GetBasicAuth() != nil && webhook.GetBasicAuth().Username == "" {
+		err = multierror.Append(err, errors.New("basic auth requires a username"))
+	}
+	return err
+}
+
+func validateEmail(email *v1.Hook_Email) error {
+	var err error
+	if email.Host == "" {
+		err = multierror.Append(err, errors.New("host is required"))
+	}
+	if email.Port < 0 || email.Port > 65535 {
+		err = multierror.Append(err, fmt.Errorf("invalid port %d", email.Port))
+	}
+	if _, e := mail.ParseAddress(email.From); e != nil {
+		err = multierror.Append(err, fmt.Errorf("invalid from address %q: %w", email.From, e))
+	}
+	if len(email.To) == 0 {
+		err = multierror.Append(err, errors.New("at least one to address is required"))
+	}
+	for _, addr := range append(slices.Clone(email.To), email.Cc...) {
+		if _, e := mail.ParseAddress(addr); e != nil {
+			err = multierror.Append(err, fmt.Errorf("invalid address %q: %w", addr, e))
+		}
+	}
+	return err
+}
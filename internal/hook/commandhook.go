@@ -1,15 +1,21 @@
 package hook
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"io"
+	"os"
 	"os/exec"
+	"strconv"
 	"strings"
+	"time"
 
 	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+	"github.com/garethgeorge/backrest/pkg/restic"
 )
 
-func (h *Hook) doCommand(cmd *v1.Hook_ActionCommand, vars HookVars, output io.Writer) error {
+func (h *Hook) doCommand(ctx context.Context, cmd *v1.Hook_ActionCommand, vars HookVars, output io.Writer) error {
 	command, err := h.renderTemplate(cmd.ActionCommand.Command, vars)
 	if err != nil {
 		return fmt.Errorf("template rendering: %w", err)
@@ -29,12 +35,131 @@ func (h *Hook) doCommand(cmd *v1.Hook_ActionCommand, vars HookVars, output io.Wr
 	output.Write([]byte(fmt.Sprintf("------- script -------\n#! %v\n%v\n", shell, command)))
 	output.Write([]byte("------- output -------\n"))
 
-	// Run the command in the specified shell
-	execCmd := exec.Command(shell)
+	// Run the command in the specified shell, it is killed if ctx is cancelled. WaitDelay bounds the wait for output from
+	// processes the command left running.
+	execCmd := exec.CommandContext(ctx, shell)
 	execCmd.Stdin = strings.NewReader(command)
+	execCmd.WaitDelay = 1 * time.Second
 
 	execCmd.Stderr = output
 	execCmd.Stdout = output
+	execCmd.Dir = cmd.ActionCommand.GetWorkingDir()
+
+	varsFile, err := writeVarsFile(vars)
+	if err != nil {
+		return err
+	}
+	defer os.Remove(varsFile)
+
+	execCmd.Env = append(os.Environ(), commandEnv(vars)...)
+	execCmd.Env = append(execCmd.Env, "BACKREST_VARS_FILE="+varsFile)
+	if user := cmd.ActionCommand.GetRunAsUser(); user != "" {
+		if err := setRunAsUser(execCmd, user, varsFile); err != nil {
+			return fmt.Errorf("run as user %q: %w", user, err)
+		}
+	}
+	for k, v := range cmd.ActionCommand.GetEnv() {
+		execCmd.Env = append(execCmd.Env, k+"="+v)
+	}
 
 	return execCmd.Run()
 }
+
+// commandEnv returns the BACKREST_ environment variables describing the event to a command hook.
+func commandEnv(vars HookVars) []string {
+	env := []string{
+		"BACKREST_EVENT=" + vars.Event.String(),
+		"BACKREST_TASK=" + vars.Task,
+		"BACKREST_REPO_ID=" + vars.Repo.GetId(),
+		"BACKREST_PLAN_ID=" + vars.Plan.GetId(),
+		"BACKREST_SNAPSHOT_ID=" + vars.SnapshotId,
+		"BACKREST_ERROR=" + vars.Error,
+		"BACKREST_ERROR_KIND=" + vars.ErrorKind,
+		"BACKREST_OPERATION_ID=" + strconv.FormatInt(vars.OperationId, 10),
+	}
+	if stats := vars.SnapshotStats; stats != nil {
+		env = append(env,
+			"BACKREST_FILES_NEW="+strconv.Itoa(stats.FilesNew),
+			"BACKREST_FILES_CHANGED="+strconv.Itoa(stats.FilesChanged),
+			"BACKREST_FILES_UNMODIFIED="+strconv.Itoa(stats.FilesUnmodified),
+			"BACKREST_DIRS_NEW="+strconv.Itoa(stats.DirsNew),
+			"BACKREST_DIRS_CHANGED="+strconv.Itoa(stats.DirsChanged),
+			"BACKREST_DIRS_UNMODIFIED="+strconv.Itoa(stats.DirsUnmodified),
+			"BACKREST_DATA_ADDED="+strconv.Itoa(stats.DataAdded),
+			"BACKREST_TOTAL_FILES_PROCESSED="+strconv.Itoa(stats.TotalFilesProcessed),
+			"BACKREST_TOTAL_BYTES_PROCESSED="+strconv.Itoa(stats.TotalBytesProcessed),
+			"BACKREST_TOTAL_DURATION="+strconv.FormatFloat(stats.TotalDuration, 'f', -1, 64),
+		)
+	}
+	if stats := vars.PruneStats; stats != nil {
+		env = append(env,
+			"BACKREST_PRUNE_BYTES_REMOVED="+strconv.FormatInt(stats.BytesRemoved, 10),
+			"BACKREST_PRUNE_BYTES_REMAINING="+strconv.FormatInt(stats.BytesRemaining, 10),
+		)
+	}
+	if stats := vars.RepoStats; stats != nil {
+		env = append(env,
+			"BACKREST_REPO_TOTAL_SIZE="+strconv.FormatInt(stats.TotalSize, 10),
+			"BACKREST_REPO_SNAPSHOT_COUNT="+strconv.FormatInt(stats.SnapshotCount, 10),
+		)
+	}
+	return env
+}
+
+// varsFile is the content of the vars file given to command hooks. It names the repo and plan by id rather than including their
+// config so that secrets e.g. the repo password, its env or the credentials of other hooks are never written to disk.
+type varsFile struct {
+	Task          string
+	Event         string
+	RepoId        string
+	PlanId        string
+	SnapshotId    string
+	OperationId   int64
+	CurTime       time.Time
+	Error         string
+	ErrorKind     string
+	Recovered     bool
+	SnapshotStats *restic.BackupProgressEntry
+	PruneStats    *restic.PruneStats
+	Forgotten     []*v1.ResticSnapshot
+	RestorePath   string
+	RestoreTarget string
+	RestoreStats  *v1.RestoreProgressEntry
+	RepoStats     *v1.RepoStats
+}
+
+// writeVarsFile writes the vars as JSON to a temporary file for the command to read, the caller removes it.
+func writeVarsFile(vars HookVars) (string, error) {
+	b, err := json.Marshal(varsFile{
+		Task:          vars.Task,
+		Event:         vars.Event.String(),
+		RepoId:        vars.Repo.GetId(),
+		PlanId:        vars.Plan.GetId(),
+		SnapshotId:    vars.SnapshotId,
+		OperationId:   vars.OperationId,
+		CurTime:       vars.CurTime,
+		Error:         vars.Error,
+		ErrorKind:     vars.ErrorKind,
+		Recovered:     vars.Recovered,
+		SnapshotStats: vars.SnapshotStats,
+		PruneStats:    vars.PruneStats,
+		Forgotten:     vars.Forgotten,
+		RestorePath:   vars.RestorePath,
+		RestoreTarget: vars.RestoreTarget,
+		RestoreStats:  vars.RestoreStats,
+		RepoStats:     vars.RepoStats,
+	})
+	if err != nil {
+		return "", fmt.Errorf("marshal vars: %w", err)
+	}
+	f, err := os.CreateTemp("", "backrest-hook-vars-*.json")
+	if err != nil {
+		return "", fmt.Errorf("create vars file: %w", err)
+	}
+	defer f.Close()
+	if _, err := f.Write(b); err != nil {
+		os.Remove(f.Name())
+		return "", fmt.Errorf("write vars file: %w", err)
+	}
+	return f.Name(), nil
+}
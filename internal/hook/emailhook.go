@@ -0,0 +1,232 @@
+package hook
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"net/textproto"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+)
+
+var (
+	defaultEmailTimeout = 30 * time.Second
+	defaultSubject      = `Backrest {{ .EventName .Event }}{{ if .Plan }} for plan {{ .Plan.Id }}{{ else if .Repo }} for repo {{ .Repo.Id }}{{ end }}`
+)
+
+func (h *Hook) doEmail(ctx context.Context, cmd *v1.Hook_ActionEmail, vars HookVars, output io.Writer) error {
+	email := cmd.ActionEmail
+
+	subject, err := h.renderTemplateOrDefault(email.GetSubjectTemplate(), defaultSubject, vars)
+	if err != nil {
+		return fmt.Errorf("subject template rendering: %w", err)
+	}
+	body, err := h.renderTemplateOrDefault(email.GetTemplate(), defaultTemplate, vars)
+	if err != nil {
+		return fmt.Errorf("template rendering: %w", err)
+	}
+	html := email.GetHtml() && strings.TrimSpace(email.GetTemplate()) != ""
+
+	var attachment []byte
+	if email.GetAttachLog() {
+		if vars.operationLog == nil {
+			fmt.Fprintf(output, "The operation has no log to attach\n")
+		}
+		attachment = vars.operationLog
+	}
+
+	msg, err := buildEmail(email, subject, body, html, attachment)
+	if err != nil {
+		return fmt.Errorf("build email: %w", err)
+	}
+
+	fmt.Fprintf(output, "Sending email to %s via %s\nSubject: %s\n%s\n", strings.Join(append(slices.Clone(email.GetTo()), email.GetCc()...), ", "), email.GetHost(), subject, body)
+	return sendEmail(ctx, email, msg)
+}
+
+// buildEmail returns the MIME message for the email, the body is sent as the only part unless there is an attachment.
+func buildEmail(email *v1.Hook_Email, subject, body string, html bool, attachment []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	header := func(k, v string) {
+		fmt.Fprintf(&buf, "%s: %s\r\n", k, v)
+	}
+	header("From", email.GetFrom())
+	header("To", strings.Join(email.GetTo(), ", "))
+	if len(email.GetCc()) > 0 {
+		header("Cc", strings.Join(email.GetCc(), ", "))
+	}
+	header("Subject", mime.QEncoding.Encode("utf-8", subject))
+	header("Date", time.Now().Format(time.RFC1123Z))
+	header("MIME-Version", "1.0")
+
+	contentType := "text/plain; charset=utf-8"
+	if html {
+		contentType = "text/html; charset=utf-8"
+	}
+
+	writeBody := func(w io.Writer) error {
+		qp := quotedprintable.NewWriter(w)
+		if _, err := qp.Write([]byte(body)); err != nil {
+			return err
+		}
+		return qp.Close()
+	}
+
+	if attachment == nil {
+		header("Content-Type", contentType)
+		header("Content-Transfer-Encoding", "quoted-printable")
+		buf.WriteString("\r\n")
+		if err := writeBody(&buf); err != nil {
+			return nil, err
+		}
+		return buf.Bytes(), nil
+	}
+
+	mw := multipart.NewWriter(&buf)
+	header("Content-Type", mime.FormatMediaType("multipart/mixed", map[string]string{"boundary": mw.Boundary()}))
+	buf.WriteString("\r\n")
+
+	part, err := mw.CreatePart(textproto.MIMEHeader{
+		"Content-Type":              {contentType},
+		"Content-Transfer-Encoding": {"quoted-printable"},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := writeBody(part); err != nil {
+		return nil, err
+	}
+
+	part, err = mw.CreatePart(textproto.MIMEHeader{
+		"Content-Type":              {"text/plain; charset=utf-8"},
+		"Content-Transfer-Encoding": {"base64"},
+		"Content-Disposition":       {mime.FormatMediaType("attachment", map[string]string{"filename": "operation.log"})},
+	})
+	if err != nil {
+		return nil, err
+	}
+	encoded := base64.StdEncoding.EncodeToString(attachment)
+	for len(encoded) > 76 {
+		if _, err := io.WriteString(part, encoded[:76]+"\r\n"); err != nil {
+			return nil, err
+		}
+		encoded = encoded[76:]
+	}
+	if _, err := io.WriteString(part, encoded+"\r\n"); err != nil {
+		return nil, err
+	}
+
+	if err := mw.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// sendEmail delivers the message over SMTP, the connection is closed if ctx is cancelled.
+func sendEmail(ctx context.Context, email *v1.Hook_Email, msg []byte) error {
+	from, err := mail.ParseAddress(email.GetFrom())
+	if err != nil {
+		return fmt.Errorf("parse from address: %w", err)
+	}
+	var recipients []string
+	for _, addr := range append(slices.Clone(email.GetTo()), email.GetCc()...) {
+		parsed, err := mail.ParseAddress(addr)
+		if err != nil {
+			return fmt.Errorf("parse recipient address: %w", err)
+		}
+		recipients = append(recipients, parsed.Address)
+	}
+
+	port := email.GetPort()
+	if port == 0 {
+		switch email.GetSecurity() {
+		case v1.Hook_Email_TLS:
+			port = 465
+		case v1.Hook_Email_NONE:
+			port = 25
+		default:
+			port = 587
+		}
+	}
+	addr := net.JoinHostPort(email.GetHost(), strconv.Itoa(int(port)))
+	tlsConfig := &tls.Config{ServerName: email.GetHost(), InsecureSkipVerify: email.GetSkipTlsVerify()}
+
+	ctx, cancel := context.WithTimeout(ctx, defaultEmailTimeout)
+	defer cancel()
+
+	var conn net.Conn
+	if email.GetSecurity() == v1.Hook_Email_TLS {
+		conn, err = (&tls.Dialer{Config: tlsConfig}).DialContext(ctx, "tcp", addr)
+	} else {
+		conn, err = (&net.Dialer{}).DialContext(ctx, "tcp", addr)
+	}
+	if err != nil {
+		return fmt.Errorf("connect to %v: %w", addr, err)
+	}
+	stop := context.AfterFunc(ctx, func() { conn.Close() })
+	defer stop()
+
+	c, err := smtp.NewClient(conn, email.GetHost())
+	if err != nil {
+		conn.Close()
+		return fmt.Errorf("smtp handshake: %w", ctxErrOr(ctx, err))
+	}
+	defer c.Close()
+
+	if security := email.GetSecurity(); security == v1.Hook_Email_UNKNOWN || security == v1.Hook_Email_STARTTLS {
+		if ok, _ := c.Extension("STARTTLS"); ok {
+			if err := c.StartTLS(tlsConfig); err != nil {
+				return fmt.Errorf("starttls: %w", ctxErrOr(ctx, err))
+			}
+		} else if security == v1.Hook_Email_STARTTLS {
+			return errors.New("server does not support STARTTLS")
+		}
+	}
+
+	if email.GetUsername() != "" {
+		if err := c.Auth(smtp.PlainAuth("", email.GetUsername(), email.GetPassword(), email.GetHost())); err != nil {
+			return fmt.Errorf("auth: %w", ctxErrOr(ctx, err))
+		}
+	}
+	if err := c.Mail(from.Address); err != nil {
+		return fmt.Errorf("mail from %v: %w", from.Address, ctxErrOr(ctx, err))
+	}
+	for _, rcpt := range recipients {
+		if err := c.Rcpt(rcpt); err != nil {
+			return fmt.Errorf("rcpt to %v: %w", rcpt, ctxErrOr(ctx, err))
+		}
+	}
+	w, err := c.Data()
+	if err != nil {
+		return fmt.Errorf("data: %w", ctxErrOr(ctx, err))
+	}
+	if _, err := w.Write(msg); err != nil {
+		return fmt.Errorf("write message: %w", ctxErrOr(ctx, err))
+	}
+	if err := w.Close(); err != nil {
+		return fmt.Errorf("send message: %w", ctxErrOr(ctx, err))
+	}
+	return c.Quit()
+}
+
+// ctxErrOr returns the context's error if it closed the connection, otherwise err.
+func ctxErrOr(ctx context.Context, err error) error {
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	return err
+}
@@ -0,0 +1,95 @@
+package hook
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+)
+
+var defaultHealthcheckMaxBodyBytes = 100000 // the largest body healthchecks.io stores.
+
+func (h *Hook) doHealthcheck(ctx context.Context, cmd *v1.Hook_ActionHealthcheck, vars HookVars, output io.Writer) error {
+	check := cmd.ActionHealthcheck
+
+	url := check.GetPingUrl() + healthcheckSuffix(check, vars)
+
+	method := http.MethodPost
+	var body string
+	if check.GetMethod() == v1.Hook_Webhook_GET {
+		method = http.MethodGet
+	} else {
+		summary, err := vars.Summary()
+		if err != nil {
+			return fmt.Errorf("render summary: %w", err)
+		}
+		maxBytes := defaultHealthcheckMaxBodyBytes
+		if check.GetMaxBodyBytes() > 0 {
+			maxBytes = int(check.GetMaxBodyBytes())
+		}
+		body = healthcheckBody(summary, vars.operationLog, maxBytes)
+	}
+
+	// pings reuse the webhook's request handling for the TLS options of self-hosted instances.
+	webhook := &v1.Hook_Webhook{
+		WebhookUrl:    url,
+		SkipTlsVerify: check.GetSkipTlsVerify(),
+		CaCertPath:    check.GetCaCertPath(),
+	}
+	client, err := webhookClient(ctx, webhook)
+	if err != nil {
+		return &permanentError{err}
+	}
+
+	fmt.Fprintf(output, "Pinging %s %s\n", method, url)
+	if body != "" {
+		fmt.Fprintf(output, "---- body ----\n%s\n", body)
+	}
+	retry, err := sendWebhook(ctx, client, webhook, method, body, output)
+	if err != nil && !retry {
+		return &permanentError{err}
+	}
+	return err
+}
+
+// healthcheckSuffix returns the suffix of the ping url for the event: start, success or fail.
+func healthcheckSuffix(check *v1.Hook_Healthcheck, vars HookVars) string {
+	if failed, _ := eventOutcome(vars.Event, vars); failed {
+		if check.GetFailSuffix() == "" {
+			return "/fail"
+		}
+		return check.GetFailSuffix()
+	}
+	switch vars.Event {
+	case v1.Hook_CONDITION_SNAPSHOT_START, v1.Hook_CONDITION_PRUNE_START, v1.Hook_CONDITION_FORGET_START,
+		v1.Hook_CONDITION_RESTORE_START, v1.Hook_CONDITION_STATS_START:
+		if check.GetStartSuffix() == "" {
+			return "/start"
+		}
+		return check.GetStartSuffix()
+	default:
+		return check.GetSuccessSuffix()
+	}
+}
+
+// healthcheckBody returns the summary followed by as much of the end of the log as fits in maxBytes.
+func healthcheckBody(summary string, log []byte, maxBytes int) string {
+	if len(summary) >= maxBytes {
+		return summary[:maxBytes]
+	}
+	if len(log) == 0 {
+		return summary
+	}
+	const logHeader = "\n---- log ----\n"
+	const truncated = "...\n"
+	avail := maxBytes - len(summary) - len(logHeader)
+	if avail <= len(truncated) {
+		return summary
+	}
+	if len(log) > avail {
+		log = append([]byte(truncated), log[len(log)-(avail-len(truncated)):]...)
+	}
+	return summary + logHeader + string(log)
+}
@@ -2,10 +2,14 @@ package hook
 
 import (
 	"bytes"
+	"context"
+	"errors"
 	"fmt"
 	"io"
+	"os/exec"
 	"slices"
 	"strings"
+	"sync"
 	"text/template"
 	"time"
 
@@ -20,21 +24,60 @@ var (
 	defaultTemplate = `{{ .Summary }}`
 )
 
+var (
+	defaultRetryBackoff        = 5 * time.Second
+	defaultDetachedHookTimeout = 10 * time.Minute // timeout of each attempt at running a hook that isn't cancelled with its operation.
+)
+
 type HookExecutor struct {
-	oplog    *oplog.OpLog
+	oplog    oplog.OpLog
 	logStore *rotatinglog.RotatingLog
+	async    sync.WaitGroup // hooks running in the background.
+
+	mu          sync.Mutex
+	globalHooks []*v1.Hook // hooks from the config that run for every repo and plan.
 }
 
-func NewHookExecutor(oplog *oplog.OpLog, bigOutputStore *rotatinglog.RotatingLog) *HookExecutor {
+func NewHookExecutor(oplog oplog.OpLog, bigOutputStore *rotatinglog.RotatingLog) *HookExecutor {
 	return &HookExecutor{
 		oplog:    oplog,
 		logStore: bigOutputStore,
 	}
 }
 
-// ExecuteHooks schedules tasks for the hooks subscribed to the given event. The vars map is used to substitute variables
-// Hooks are pulled both from the provided plan and from the repo config.
-func (e *HookExecutor) ExecuteHooks(repo *v1.Repo, plan *v1.Plan, snapshotId string, events []v1.Hook_Condition, vars HookVars) {
+// SetGlobalHooks replaces the hooks evaluated for the events of every repo and plan.
+func (e *HookExecutor) SetGlobalHooks(hooks []*v1.Hook) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.globalHooks = hooks
+}
+
+// Wait blocks until the async hooks running in the background complete or ctx is done.
+func (e *HookExecutor) Wait(ctx context.Context) error {
+	done := make(chan struct{})
+	go func() {
+		e.async.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// ExecuteHooks runs the hooks subscribed to the given events in order. The vars map is used to substitute variables
+// Hooks are pulled from the repo config, then the provided plan and then the global hooks that neither the repo nor the plan skip.
+//
+// A failing hook is logged and skipped unless its OnError asks otherwise and the events are at the start of an operation, in which
+// case the remaining hooks are not run and a *HookError is returned. Callers running hooks before an operation should fail or skip
+// the operation accordingly. Hooks for the end of an operation all run whatever their failures, the operation is already over.
+//
+// Hooks for the start of an operation are cancelled with ctx. Hooks for the end of an operation are not, so that the failure of an
+// operation cancelled or timed out with ctx is still reported, they are bounded by their own timeout or by defaultDetachedHookTimeout.
+// Async hooks are never cancelled with ctx, they run in the background until they complete or time out.
+func (e *HookExecutor) ExecuteHooks(ctx context.Context, repo *v1.Repo, plan *v1.Plan, snapshotId string, events []v1.Hook_Condition, vars HookVars) error {
 	operationBase := v1.Operation{
 		Status:     v1.OperationStatus_STATUS_INPROGRESS,
 		PlanId:     plan.GetId(),
@@ -47,43 +90,136 @@ func (e *HookExecutor) ExecuteHooks(repo *v1.Repo, plan *v1.Plan, snapshotId str
 	vars.Plan = plan
 	vars.CurTime = time.Now()
 
+	type namedHook struct {
+		name string
+		hook *Hook
+	}
+	var hooks []namedHook
 	for idx, hook := range repo.GetHooks() {
-		h := (*Hook)(hook)
-		event := firstMatchingCondition(h, events)
-		if event == v1.Hook_CONDITION_UNKNOWN {
+		hooks = append(hooks, namedHook{fmt.Sprintf("repo/%v/hook/%v", repo.Id, idx), (*Hook)(hook)})
+	}
+	for idx, hook := range plan.GetHooks() {
+		hooks = append(hooks, namedHook{fmt.Sprintf("plan/%v/hook/%v", plan.Id, idx), (*Hook)(hook)})
+	}
+	e.mu.Lock()
+	for idx, hook := range e.globalHooks {
+		if hook.Name != "" && (slices.Contains(repo.GetSkipGlobalHooks(), hook.Name) || slices.Contains(plan.GetSkipGlobalHooks(), hook.Name)) {
 			continue
 		}
-
-		name := fmt.Sprintf("repo/%v/hook/%v", repo.Id, idx)
-		operation := proto.Clone(&operationBase).(*v1.Operation)
-		operation.UnixTimeStartMs = curTimeMs()
-		operation.Op = &v1.Operation_OperationRunHook{
-			OperationRunHook: &v1.OperationRunHook{
-				Name: name,
-			},
+		name := fmt.Sprintf("global/hook/%v", idx)
+		if hook.Name != "" {
+			name = fmt.Sprintf("global/hook/%v", hook.Name)
 		}
-		zap.L().Info("Running hook", zap.String("plan", plan.Id), zap.Int64("opId", operation.Id), zap.String("hook", name))
-		e.executeHook(operation, h, event, vars)
+		hooks = append(hooks, namedHook{name, (*Hook)(hook)})
 	}
+	e.mu.Unlock()
 
-	for idx, hook := range plan.GetHooks() {
-		h := (*Hook)(hook)
-		event := firstMatchingCondition(h, events)
+	recovered := make(map[v1.Hook_Condition]bool)
+	for _, h := range hooks {
+		event := firstMatchingCondition(h.hook, events)
 		if event == v1.Hook_CONDITION_UNKNOWN {
 			continue
 		}
+		if _, ok := recovered[event]; !ok {
+			recovered[event] = e.recovered(event, vars)
+		}
+		hookVars := vars
+		hookVars.Recovered = recovered[event]
 
-		name := fmt.Sprintf("plan/%v/hook/%v", plan.Id, idx)
 		operation := proto.Clone(&operationBase).(*v1.Operation)
 		operation.UnixTimeStartMs = curTimeMs()
 		operation.Op = &v1.Operation_OperationRunHook{
 			OperationRunHook: &v1.OperationRunHook{
-				Name: name,
+				Name:      h.name,
+				Condition: event,
 			},
 		}
-		zap.L().Info("Running hook", zap.String("plan", plan.Id), zap.Int64("opId", operation.Id), zap.String("hook", name))
-		e.executeHook(operation, h, event, vars)
+
+		if policy := h.hook.NotificationPolicy; policy != nil {
+			if reason, err := e.notificationPolicyHelper(policy, h.name, event, hookVars); err != nil {
+				zap.S().Errorf("execute hook: notification policy: %v", err)
+			} else if reason != "" {
+				e.recordSuppressed(operation, reason)
+				continue
+			}
+			if policy.Digest {
+				suppressed, err := e.suppressedSince(h.name, hookVars)
+				if err != nil {
+					zap.S().Errorf("execute hook: query suppressed runs: %v", err)
+				}
+				hookVars.Suppressed = suppressed
+			}
+		}
+		zap.L().Info("Running hook", zap.String("plan", plan.GetId()), zap.Int64("opId", operation.Id), zap.String("hook", h.name), zap.Bool("async", h.hook.Async))
+		if h.hook.Async {
+			e.async.Add(1)
+			go func(h namedHook) {
+				defer e.async.Done()
+				e.executeHook(context.WithoutCancel(ctx), operation, h.hook, event, hookVars)
+			}(h)
+			continue
+		}
+		hookCtx := ctx
+		if !isStartCondition(event) {
+			hookCtx = context.WithoutCancel(ctx)
+		}
+		if err := e.executeHook(hookCtx, operation, h.hook, event, hookVars); err != nil && isStartCondition(event) {
+			return err
+		}
 	}
+	return nil
+}
+
+// recovered returns true if the event reports a success and the previous operation of the same type failed.
+func (e *HookExecutor) recovered(event v1.Hook_Condition, vars HookVars) bool {
+	if failed, ok := eventOutcome(event, vars); !ok || failed {
+		return false
+	}
+	prevFailed, found, err := e.previousFailed(event, vars)
+	if err != nil {
+		zap.S().Errorf("execute hook: query previous outcome: %v", err)
+	}
+	return found && prevFailed
+}
+
+// recordSuppressed records a run of a hook suppressed by its notification policy.
+func (e *HookExecutor) recordSuppressed(op *v1.Operation, reason string) {
+	op.Op.(*v1.Operation_OperationRunHook).OperationRunHook.Suppressed = true
+	op.Status = v1.OperationStatus_STATUS_SYSTEM_CANCELLED
+	op.DisplayMessage = "suppressed by notification policy: " + reason
+	op.UnixTimeEndMs = op.UnixTimeStartMs
+	zap.L().Info("Suppressed hook", zap.String("plan", op.PlanId), zap.String("hook", op.GetOperationRunHook().GetName()), zap.String("reason", reason))
+	if err := e.oplog.Add(op); err != nil {
+		zap.S().Errorf("execute hook: add operation: %v", err)
+	}
+}
+
+// HookError is returned by ExecuteHooks when a hook fails with ON_ERROR_FATAL or asks for the operation to be skipped.
+type HookError struct {
+	Name   string // name of the hook e.g. plan/<id>/hook/<idx> or global/hook/<name>.
+	Cancel bool   // the operation should be skipped rather than failed.
+	Err    error
+}
+
+func (e *HookError) Error() string {
+	if e.Cancel {
+		return fmt.Sprintf("hook %v requested the operation be skipped: %v", e.Name, e.Err)
+	}
+	return fmt.Sprintf("hook %v failed: %v", e.Name, e.Err)
+}
+
+func (e *HookError) Unwrap() error {
+	return e.Err
+}
+
+// isStartCondition returns true if the condition runs hooks before an operation, their failures can fail or skip the operation.
+func isStartCondition(cond v1.Hook_Condition) bool {
+	switch cond {
+	case v1.Hook_CONDITION_SNAPSHOT_START, v1.Hook_CONDITION_PRUNE_START, v1.Hook_CONDITION_FORGET_START,
+		v1.Hook_CONDITION_RESTORE_START, v1.Hook_CONDITION_STATS_START:
+		return true
+	}
+	return false
 }
 
 func firstMatchingCondition(hook *Hook, events []v1.Hook_Condition) v1.Hook_Condition {
@@ -95,19 +231,40 @@ func firstMatchingCondition(hook *Hook, events []v1.Hook_Condition) v1.Hook_Cond
 	return v1.Hook_CONDITION_UNKNOWN
 }
 
-func (e *HookExecutor) executeHook(op *v1.Operation, hook *Hook, event v1.Hook_Condition, vars HookVars) {
+// executeHook runs the hook and records it as an operation, returning a *HookError if the failure should stop the operation that
+// triggered it. Async hooks never stop the operation.
+func (e *HookExecutor) executeHook(ctx context.Context, op *v1.Operation, hook *Hook, event v1.Hook_Condition, vars HookVars) error {
 	if err := e.oplog.Add(op); err != nil {
 		zap.S().Errorf("execute hook: add operation: %v", err)
-		return
+		return nil
 	}
 
 	output := &bytes.Buffer{}
 
-	if err := hook.Do(event, vars, io.MultiWriter(output)); err != nil {
-		output.Write([]byte(fmt.Sprintf("Error: %v", err)))
-		op.DisplayMessage = err.Error()
-		op.Status = v1.OperationStatus_STATUS_ERROR
-		zap.S().Errorf("execute hook: %v", err)
+	if (*v1.Hook)(hook).GetActionEmail().GetAttachLog() || (*v1.Hook)(hook).GetActionHealthcheck() != nil {
+		vars.operationLog = e.operationLog(vars.OperationId)
+	}
+
+	var hookErr *HookError
+	if err := hook.doWithRetries(ctx, event, vars, output); err != nil {
+		name := op.GetOperationRunHook().GetName()
+		if hook.isSkipExitCode(err) {
+			output.Write([]byte(fmt.Sprintf("Exited with the skip exit code, skipping the operation: %v", err)))
+			op.DisplayMessage = "requested the operation be skipped"
+			op.Status = v1.OperationStatus_STATUS_SUCCESS
+			hookErr = &HookError{Name: name, Cancel: true, Err: err}
+		} else {
+			output.Write([]byte(fmt.Sprintf("Error: %v", err)))
+			op.DisplayMessage = err.Error()
+			op.Status = v1.OperationStatus_STATUS_ERROR
+			zap.S().Errorf("execute hook: %v", err)
+			switch hook.OnError {
+			case v1.Hook_ON_ERROR_FATAL:
+				hookErr = &HookError{Name: name, Err: err}
+			case v1.Hook_ON_ERROR_CANCEL:
+				hookErr = &HookError{Name: name, Cancel: true, Err: err}
+			}
+		}
 	} else {
 		op.Status = v1.OperationStatus_STATUS_SUCCESS
 	}
@@ -115,15 +272,94 @@ func (e *HookExecutor) executeHook(op *v1.Operation, hook *Hook, event v1.Hook_C
 	outputRef, err := e.logStore.Write(output.Bytes())
 	if err != nil {
 		zap.S().Errorf("execute hook: write log: %v", err)
-		return
+	} else {
+		op.Op.(*v1.Operation_OperationRunHook).OperationRunHook.OutputLogref = outputRef
 	}
-	op.Op.(*v1.Operation_OperationRunHook).OperationRunHook.OutputLogref = outputRef
 
 	op.UnixTimeEndMs = curTimeMs()
 	if err := e.oplog.Update(op); err != nil {
 		zap.S().Errorf("execute hook: update operation: %v", err)
-		return
 	}
+
+	if hookErr != nil && !hook.Async {
+		return hookErr
+	}
+	return nil
+}
+
+// operationLog returns the log of the operation, or nil if it has none yet.
+func (e *HookExecutor) operationLog(opId int64) []byte {
+	if opId == 0 {
+		return nil
+	}
+	op, err := e.oplog.Get(opId)
+	if err != nil || op.Logref == "" {
+		return nil
+	}
+	data, err := e.logStore.Read(op.Logref)
+	if err != nil {
+		zap.S().Errorf("execute hook: read operation log: %v", err)
+		return nil
+	}
+	return data
+}
+
+// doWithRetries runs the hook, applying its timeout to each attempt and retrying failures with exponential backoff. A skip exit
+// code is a deliberate result and is not retried.
+func (h *Hook) doWithRetries(ctx context.Context, event v1.Hook_Condition, vars HookVars, output io.Writer) error {
+	backoff := defaultRetryBackoff
+	if h.RetryBackoffSeconds > 0 {
+		backoff = time.Duration(h.RetryBackoffSeconds) * time.Second
+	}
+
+	timeout := time.Duration(h.TimeoutSeconds) * time.Second
+	if timeout == 0 && (h.Async || !isStartCondition(event)) {
+		timeout = defaultDetachedHookTimeout // the hook isn't cancelled with its operation, it mustn't run forever.
+	}
+
+	for attempt := 0; ; attempt++ {
+		attemptCtx, cancel := ctx, context.CancelFunc(func() {})
+		if timeout > 0 {
+			attemptCtx, cancel = context.WithTimeout(ctx, timeout)
+		}
+		err := h.Do(attemptCtx, event, vars, output)
+		if err != nil && attemptCtx.Err() == context.DeadlineExceeded && ctx.Err() == nil {
+			err = fmt.Errorf("timed out after %v: %w", timeout, err)
+		}
+		cancel()
+
+		var permanent *permanentError
+		if err == nil || h.isSkipExitCode(err) || errors.As(err, &permanent) || attempt >= int(h.Retries) || ctx.Err() != nil {
+			return err
+		}
+		fmt.Fprintf(output, "\nAttempt %d failed: %v, retrying in %v\n", attempt+1, err, backoff)
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+}
+
+// permanentError is a failure of a hook's action that would fail the same way again, it isn't retried.
+type permanentError struct {
+	err error
+}
+
+func (e *permanentError) Error() string {
+	return e.err.Error()
+}
+
+func (e *permanentError) Unwrap() error {
+	return e.err
+}
+
+// isSkipExitCode returns true if err is the exit of a command hook with its configured skip exit code.
+func (h *Hook) isSkipExitCode(err error) bool {
+	skipCode := (*v1.Hook)(h).GetActionCommand().GetSkipExitCode()
+	var exitErr *exec.ExitError
+	return skipCode != 0 && errors.As(err, &exitErr) && exitErr.ExitCode() == int(skipCode)
 }
 
 func curTimeMs() int64 {
@@ -132,7 +368,8 @@ func curTimeMs() int64 {
 
 type Hook v1.Hook
 
-func (h *Hook) Do(event v1.Hook_Condition, vars HookVars, output io.Writer) error {
+// Do runs the hook's action once if it is subscribed to the event, the action is cancelled with ctx.
+func (h *Hook) Do(ctx context.Context, event v1.Hook_Condition, vars HookVars, output io.Writer) error {
 	if !slices.Contains(h.Conditions, event) {
 		return nil
 	}
@@ -141,15 +378,21 @@ func (h *Hook) Do(event v1.Hook_Condition, vars HookVars, output io.Writer) erro
 
 	switch action := h.Action.(type) {
 	case *v1.Hook_ActionCommand:
-		return h.doCommand(action, vars, output)
+		return h.doCommand(ctx, action, vars, output)
+	case *v1.Hook_ActionWebhook:
+		return h.doWebhook(ctx, action, vars, output)
 	case *v1.Hook_ActionDiscord:
-		return h.doDiscord(action, vars, output)
+		return h.doDiscord(ctx, action, vars, output)
 	case *v1.Hook_ActionGotify:
-		return h.doGotify(action, vars, output)
+		return h.doGotify(ctx, action, vars, output)
 	case *v1.Hook_ActionSlack:
-		return h.doSlack(action, vars, output)
+		return h.doSlack(ctx, action, vars, output)
 	case *v1.Hook_ActionShoutrrr:
-		return h.doShoutrrr(action, vars, output)
+		return h.doShoutrrr(ctx, action, vars, output)
+	case *v1.Hook_ActionEmail:
+		return h.doEmail(ctx, action, vars, output)
+	case *v1.Hook_ActionHealthcheck:
+		return h.doHealthcheck(ctx, action, vars, output)
 	default:
 		return fmt.Errorf("unknown hook action: %v", action)
 	}
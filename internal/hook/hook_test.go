@@ -2,10 +2,26 @@ package hook
 
 import (
 	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
 	"os/exec"
+	"path"
+	"slices"
+	"strings"
 	"testing"
+	"time"
 
 	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+	"github.com/garethgeorge/backrest/internal/oplog"
+	"github.com/garethgeorge/backrest/internal/rotatinglog"
+	"github.com/garethgeorge/backrest/pkg/restic"
+	"github.com/garethgeorge/backrest/test/helpers"
 )
 
 func TestHookCommandInDefaultShell(t *testing.T) {
@@ -18,7 +34,7 @@ func TestHookCommandInDefaultShell(t *testing.T) {
 		},
 	})
 
-	err := hook.Do(v1.Hook_CONDITION_SNAPSHOT_START, HookVars{}, &bytes.Buffer{})
+	err := hook.Do(context.Background(), v1.Hook_CONDITION_SNAPSHOT_START, HookVars{}, &bytes.Buffer{})
 	if err == nil {
 		t.Fatal("expected error")
 	}
@@ -43,7 +59,7 @@ exit $counter`,
 		},
 	})
 
-	err := hook.Do(v1.Hook_CONDITION_SNAPSHOT_START, HookVars{}, &bytes.Buffer{})
+	err := hook.Do(context.Background(), v1.Hook_CONDITION_SNAPSHOT_START, HookVars{}, &bytes.Buffer{})
 	if err == nil {
 		t.Fatal("expected error")
 	}
@@ -51,3 +67,807 @@ exit $counter`,
 		t.Fatalf("expected exit code 3, got %v", err.(*exec.ExitError).ExitCode())
 	}
 }
+
+func TestHookCommandEnv(t *testing.T) {
+	dir := t.TempDir()
+	hook := Hook(v1.Hook{
+		Conditions: []v1.Hook_Condition{v1.Hook_CONDITION_SNAPSHOT_ERROR},
+		Action: &v1.Hook_ActionCommand{
+			ActionCommand: &v1.Hook_Command{
+				Command:    `echo "$PWD|$BACKREST_EVENT|$BACKREST_PLAN_ID|$BACKREST_ERROR|$BACKREST_FILES_NEW|$EXTRA"; cat "$BACKREST_VARS_FILE"`,
+				WorkingDir: dir,
+				Env:        map[string]string{"EXTRA": "extra value"},
+			},
+		},
+	})
+	vars := HookVars{
+		Repo:          &v1.Repo{Id: "repo1", Password: "repo-secret", Env: []string{"AWS_SECRET_ACCESS_KEY=env-secret"}},
+		Plan:          &v1.Plan{Id: "plan1"},
+		Error:         `it's "quoted"`,
+		SnapshotStats: &restic.BackupProgressEntry{FilesNew: 5},
+	}
+
+	var output bytes.Buffer
+	if err := hook.Do(context.Background(), v1.Hook_CONDITION_SNAPSHOT_ERROR, vars, &output); err != nil {
+		t.Fatalf("unexpected error: %v, output: %s", err, output.String())
+	}
+	want := dir + `|CONDITION_SNAPSHOT_ERROR|plan1|it's "quoted"|5|extra value`
+	if !strings.Contains(output.String(), want) {
+		t.Errorf("want output to contain %q, got %q", want, output.String())
+	}
+	if !strings.Contains(output.String(), `"RepoId":"repo1"`) || !strings.Contains(output.String(), `"Error":"it's \"quoted\""`) {
+		t.Errorf("want output to contain the vars file, got %q", output.String())
+	}
+	if strings.Contains(output.String(), "secret") {
+		t.Errorf("want the vars file to leave out the repo's secrets, got %q", output.String())
+	}
+}
+
+func TestHookWebhook(t *testing.T) {
+	var gotMethod, gotBody, gotContentType, gotHeader, gotUser, gotPassword string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		gotMethod = r.Method
+		gotBody = string(body)
+		gotContentType = r.Header.Get("Content-Type")
+		gotHeader = r.Header.Get("X-Test")
+		gotUser, gotPassword, _ = r.BasicAuth()
+		w.Write([]byte("ok"))
+	}))
+	defer server.Close()
+
+	hook := Hook(v1.Hook{
+		Conditions: []v1.Hook_Condition{v1.Hook_CONDITION_SNAPSHOT_START},
+		Action: &v1.Hook_ActionWebhook{
+			ActionWebhook: &v1.Hook_Webhook{
+				WebhookUrl:  server.URL,
+				Method:      v1.Hook_Webhook_PUT,
+				Headers:     map[string]string{"X-Test": "value"},
+				Template:    `{"plan": "{{ .Plan.Id }}"}`,
+				ContentType: "application/json",
+				Auth:        &v1.Hook_Webhook_BasicAuth_{BasicAuth: &v1.Hook_Webhook_BasicAuth{Username: "user", Password: "pass"}},
+			},
+		},
+	})
+
+	output := &bytes.Buffer{}
+	if err := hook.Do(context.Background(), v1.Hook_CONDITION_SNAPSHOT_START, HookVars{Plan: &v1.Plan{Id: "plan1"}}, output); err != nil {
+		t.Fatalf("unexpected error: %v, output: %s", err, output)
+	}
+	if gotMethod != http.MethodPut || gotBody != `{"plan": "plan1"}` || gotContentType != "application/json" || gotHeader != "value" || gotUser != "user" || gotPassword != "pass" {
+		t.Errorf("unexpected request: method %q, body %q, content type %q, header %q, basic auth %q:%q", gotMethod, gotBody, gotContentType, gotHeader, gotUser, gotPassword)
+	}
+	if !bytes.Contains(output.Bytes(), []byte("ok")) {
+		t.Errorf("expected the response in the output, got %q", output)
+	}
+}
+
+func TestHookWebhookBearerToken(t *testing.T) {
+	var gotMethod, gotAuth string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotMethod = r.Method
+		gotAuth = r.Header.Get("Authorization")
+	}))
+	defer server.Close()
+
+	hook := Hook(v1.Hook{
+		Conditions: []v1.Hook_Condition{v1.Hook_CONDITION_SNAPSHOT_START},
+		Action: &v1.Hook_ActionWebhook{
+			ActionWebhook: &v1.Hook_Webhook{
+				WebhookUrl: server.URL,
+				Method:     v1.Hook_Webhook_GET,
+				Auth:       &v1.Hook_Webhook_BearerToken{BearerToken: "token"},
+			},
+		},
+	})
+
+	if err := hook.Do(context.Background(), v1.Hook_CONDITION_SNAPSHOT_START, HookVars{}, &bytes.Buffer{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if gotMethod != http.MethodGet || gotAuth != "Bearer token" {
+		t.Errorf("unexpected request: method %q, authorization %q", gotMethod, gotAuth)
+	}
+}
+
+func TestHookWebhookRetries(t *testing.T) {
+	defaultRetryBackoff = time.Millisecond
+
+	tcs := []struct {
+		name         string
+		statuses     []int
+		retries      int32
+		wantErr      bool
+		wantRequests int
+	}{
+		{name: "retries server errors", statuses: []int{503, 429, 200}, retries: 2, wantRequests: 3},
+		{name: "gives up after retries", statuses: []int{503, 503, 503}, retries: 1, wantErr: true, wantRequests: 2},
+		{name: "no retry on client errors", statuses: []int{400, 200}, retries: 2, wantErr: true, wantRequests: 1},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			requests := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tc.statuses[requests])
+				requests++
+			}))
+			defer server.Close()
+
+			hook := Hook(v1.Hook{
+				Conditions: []v1.Hook_Condition{v1.Hook_CONDITION_SNAPSHOT_START},
+				Retries:    tc.retries,
+				Action: &v1.Hook_ActionWebhook{
+					ActionWebhook: &v1.Hook_Webhook{WebhookUrl: server.URL},
+				},
+			})
+			err := hook.doWithRetries(context.Background(), v1.Hook_CONDITION_SNAPSHOT_START, HookVars{Repo: &v1.Repo{Id: "repo1"}, Plan: &v1.Plan{Id: "plan1"}}, &bytes.Buffer{})
+			if (err != nil) != tc.wantErr {
+				t.Errorf("want error: %v, got %v", tc.wantErr, err)
+			}
+			if requests != tc.wantRequests {
+				t.Errorf("want %d requests, got %d", tc.wantRequests, requests)
+			}
+		})
+	}
+}
+
+func TestHookWebhookTLS(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	caCertPath := path.Join(t.TempDir(), "ca.pem")
+	if err := os.WriteFile(caCertPath, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}), 0600); err != nil {
+		t.Fatalf("failed to write ca cert: %v", err)
+	}
+
+	tcs := []struct {
+		name    string
+		webhook *v1.Hook_Webhook
+		wantErr bool
+	}{
+		{name: "untrusted certificate", webhook: &v1.Hook_Webhook{WebhookUrl: server.URL}, wantErr: true},
+		{name: "skip verification", webhook: &v1.Hook_Webhook{WebhookUrl: server.URL, SkipTlsVerify: true}},
+		{name: "custom ca", webhook: &v1.Hook_Webhook{WebhookUrl: server.URL, CaCertPath: caCertPath}},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			// certificate errors fail the same way again and aren't retried.
+			hook := Hook(v1.Hook{
+				Conditions: []v1.Hook_Condition{v1.Hook_CONDITION_SNAPSHOT_START},
+				Retries:    2,
+				Action:     &v1.Hook_ActionWebhook{ActionWebhook: tc.webhook},
+			})
+			if err := hook.doWithRetries(context.Background(), v1.Hook_CONDITION_SNAPSHOT_START, HookVars{Repo: &v1.Repo{Id: "repo1"}, Plan: &v1.Plan{Id: "plan1"}}, &bytes.Buffer{}); (err != nil) != tc.wantErr {
+				t.Errorf("want error: %v, got %v", tc.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestHookVarsSummary(t *testing.T) {
+	vars := HookVars{
+		Task:          "task",
+		Repo:          &v1.Repo{Id: "repo1"},
+		Plan:          &v1.Plan{Id: "plan1"},
+		SnapshotId:    "abcdef",
+		PruneStats:    &restic.PruneStats{BytesRemoved: 1000, BytesRemaining: 2000},
+		Forgotten:     []*v1.ResticSnapshot{{Id: "snapshot1"}, {Id: "snapshot2"}},
+		RestorePath:   "/data",
+		RestoreTarget: "/restore",
+		RestoreStats:  &v1.RestoreProgressEntry{FilesRestored: 3, BytesRestored: 4000},
+		RepoStats:     &v1.RepoStats{TotalSize: 5000, SnapshotCount: 6},
+	}
+
+	tcs := []struct {
+		event v1.Hook_Condition
+		want  []string
+	}{
+		{event: v1.Hook_CONDITION_PRUNE_START, want: []string{"prune start", "Repo: repo1"}},
+		{event: v1.Hook_CONDITION_PRUNE_SUCCESS, want: []string{"prune success", "Prune: removed"}},
+		{event: v1.Hook_CONDITION_FORGET_SUCCESS, want: []string{"- snapshot1\n- snapshot2"}},
+		{event: v1.Hook_CONDITION_RESTORE_START, want: []string{"Restoring: abcdef:/data to /restore"}},
+		{event: v1.Hook_CONDITION_RESTORE_SUCCESS, want: []string{"Restore: 3 files"}},
+		{event: v1.Hook_CONDITION_STATS_SUCCESS, want: []string{"in 6 snapshots"}},
+		{event: v1.Hook_CONDITION_STATS_ERROR, want: []string{"Notification for Error"}},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.event.String(), func(t *testing.T) {
+			vars := vars
+			vars.Event = tc.event
+			summary, err := vars.Summary()
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			for _, want := range tc.want {
+				if !strings.Contains(summary, want) {
+					t.Errorf("summary %q does not contain %q", summary, want)
+				}
+			}
+		})
+	}
+
+	vars.Event = v1.Hook_CONDITION_SNAPSHOT_END
+	vars.Recovered = true
+	vars.Suppressed = []SuppressedEvent{{Event: v1.Hook_CONDITION_SNAPSHOT_END, Time: time.Unix(0, 0).UTC(), Reason: "suppressed"}}
+	summary, err := vars.Summary()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, want := range []string{"Recovered: the previous backup failed", "- snapshot end at 1970-01-01T00:00:00Z: suppressed"} {
+		if !strings.Contains(summary, want) {
+			t.Errorf("summary %q does not contain %q", summary, want)
+		}
+	}
+
+	if !vars.IsError(v1.Hook_CONDITION_PRUNE_ERROR) || vars.IsError(v1.Hook_CONDITION_PRUNE_SUCCESS) {
+		t.Errorf("IsError misclassifies prune conditions")
+	}
+}
+
+func TestExecuteHooksOnError(t *testing.T) {
+	command := func(cmd string, onError v1.Hook_OnError, skipExitCode int32) *v1.Hook {
+		return &v1.Hook{
+			Conditions: []v1.Hook_Condition{v1.Hook_CONDITION_SNAPSHOT_START, v1.Hook_CONDITION_SNAPSHOT_END},
+			OnError:    onError,
+			Action:     &v1.Hook_ActionCommand{ActionCommand: &v1.Hook_Command{Command: cmd, SkipExitCode: skipExitCode}},
+		}
+	}
+
+	tcs := []struct {
+		name       string
+		hook       *v1.Hook
+		event      v1.Hook_Condition // defaults to CONDITION_SNAPSHOT_START.
+		wantErr    bool
+		wantCancel bool
+		wantRun    int // hooks run, the hook under test is followed by a succeeding hook.
+	}{
+		{name: "success", hook: command("exit 0", v1.Hook_ON_ERROR_FATAL, 0), wantRun: 2},
+		{name: "ignore", hook: command("exit 1", v1.Hook_ON_ERROR_IGNORE, 0), wantRun: 2},
+		{name: "fatal", hook: command("exit 1", v1.Hook_ON_ERROR_FATAL, 0), wantErr: true, wantRun: 1},
+		{name: "cancel", hook: command("exit 1", v1.Hook_ON_ERROR_CANCEL, 0), wantErr: true, wantCancel: true, wantRun: 1},
+		{name: "skip exit code", hook: command("exit 3", v1.Hook_ON_ERROR_IGNORE, 3), wantErr: true, wantCancel: true, wantRun: 1},
+		{name: "other exit code", hook: command("exit 1", v1.Hook_ON_ERROR_IGNORE, 3), wantRun: 2},
+		{name: "fatal after the operation", hook: command("exit 1", v1.Hook_ON_ERROR_FATAL, 0), event: v1.Hook_CONDITION_SNAPSHOT_END, wantRun: 2},
+		{name: "cancel after the operation", hook: command("exit 1", v1.Hook_ON_ERROR_CANCEL, 0), event: v1.Hook_CONDITION_SNAPSHOT_END, wantRun: 2},
+		{name: "skip exit code after the operation", hook: command("exit 3", v1.Hook_ON_ERROR_IGNORE, 3), event: v1.Hook_CONDITION_SNAPSHOT_END, wantRun: 2},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			log := oplog.NewMemStore()
+			executor := NewHookExecutor(log, rotatinglog.NewRotatingLog(t.TempDir(), 10))
+			plan := &v1.Plan{Id: "plan1", Hooks: []*v1.Hook{tc.hook, command("exit 0", v1.Hook_ON_ERROR_IGNORE, 0)}}
+			event := tc.event
+			if event == v1.Hook_CONDITION_UNKNOWN {
+				event = v1.Hook_CONDITION_SNAPSHOT_START
+			}
+
+			err := executor.ExecuteHooks(context.Background(), &v1.Repo{Id: "repo1"}, plan, "", []v1.Hook_Condition{event}, HookVars{})
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("want error: %v, got %v", tc.wantErr, err)
+			}
+			var hookErr *HookError
+			if err != nil && (!errors.As(err, &hookErr) || hookErr.Cancel != tc.wantCancel || hookErr.Name != "plan/plan1/hook/0") {
+				t.Errorf("want a HookError for plan/plan1/hook/0 with cancel %v, got %#v", tc.wantCancel, err)
+			}
+
+			run := 0
+			if _, err := log.Query(oplog.Query{}, func(op *v1.Operation) error {
+				run++
+				return nil
+			}); err != nil {
+				t.Fatalf("failed to query operations: %v", err)
+			}
+			if run != tc.wantRun {
+				t.Errorf("want %d hooks run, got %d", tc.wantRun, run)
+			}
+		})
+	}
+}
+
+func TestHookTimeoutsAndRetries(t *testing.T) {
+	defaultRetryBackoff = time.Millisecond
+	defaultDetachedHookTimeout = 100 * time.Millisecond
+
+	counter := path.Join(t.TempDir(), "counter")
+	// fails on the first two attempts and succeeds on the third.
+	flaky := `n=$(cat ` + counter + ` 2>/dev/null || echo 0); echo $((n+1)) > ` + counter + `; [ $n -ge 2 ]`
+
+	tcs := []struct {
+		name    string
+		hook    *v1.Hook
+		event   v1.Hook_Condition // defaults to CONDITION_SNAPSHOT_START.
+		ctx     func() (context.Context, context.CancelFunc)
+		wantErr string
+	}{
+		{
+			name: "timeout",
+			hook: &v1.Hook{TimeoutSeconds: 1, Action: &v1.Hook_ActionCommand{ActionCommand: &v1.Hook_Command{Command: "sleep 30"}}},
+			ctx: func() (context.Context, context.CancelFunc) {
+				return context.WithCancel(context.Background())
+			},
+			wantErr: "timed out after 1s",
+		},
+		{
+			name: "cancelled",
+			hook: &v1.Hook{Retries: 5, Action: &v1.Hook_ActionCommand{ActionCommand: &v1.Hook_Command{Command: "sleep 30"}}},
+			ctx: func() (context.Context, context.CancelFunc) {
+				return context.WithTimeout(context.Background(), 100*time.Millisecond)
+			},
+			wantErr: "signal: killed",
+		},
+		{
+			name:  "end hooks time out by default",
+			hook:  &v1.Hook{Action: &v1.Hook_ActionCommand{ActionCommand: &v1.Hook_Command{Command: "sleep 30"}}},
+			event: v1.Hook_CONDITION_SNAPSHOT_END,
+			ctx: func() (context.Context, context.CancelFunc) {
+				return context.WithCancel(context.Background())
+			},
+			wantErr: "timed out after 100ms",
+		},
+		{
+			name: "retries until success",
+			hook: &v1.Hook{Retries: 2, Action: &v1.Hook_ActionCommand{ActionCommand: &v1.Hook_Command{Command: flaky}}},
+			ctx: func() (context.Context, context.CancelFunc) {
+				return context.WithCancel(context.Background())
+			},
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			event := tc.event
+			if event == v1.Hook_CONDITION_UNKNOWN {
+				event = v1.Hook_CONDITION_SNAPSHOT_START
+			}
+			tc.hook.Conditions = []v1.Hook_Condition{event}
+			ctx, cancel := tc.ctx()
+			defer cancel()
+
+			start := time.Now()
+			err := (*Hook)(tc.hook).doWithRetries(ctx, event, HookVars{}, &bytes.Buffer{})
+			if tc.wantErr == "" && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			} else if tc.wantErr != "" && (err == nil || !strings.Contains(err.Error(), tc.wantErr)) {
+				t.Fatalf("want error containing %q, got %v", tc.wantErr, err)
+			}
+			if elapsed := time.Since(start); elapsed > 10*time.Second {
+				t.Errorf("hook took %v, expected it to be stopped", elapsed)
+			}
+		})
+	}
+}
+
+func TestExecuteHooksCancelledOperation(t *testing.T) {
+	log := oplog.NewMemStore()
+	executor := NewHookExecutor(log, rotatinglog.NewRotatingLog(t.TempDir(), 10))
+	plan := &v1.Plan{Id: "plan1", Hooks: []*v1.Hook{{
+		Conditions: []v1.Hook_Condition{v1.Hook_CONDITION_SNAPSHOT_START, v1.Hook_CONDITION_SNAPSHOT_ERROR},
+		Action:     &v1.Hook_ActionCommand{ActionCommand: &v1.Hook_Command{Command: "exit 0"}},
+	}}}
+
+	// the operation was cancelled, its error hooks still report it but a start hook isn't run for it.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	for _, event := range []v1.Hook_Condition{v1.Hook_CONDITION_SNAPSHOT_START, v1.Hook_CONDITION_SNAPSHOT_ERROR} {
+		executor.ExecuteHooks(ctx, &v1.Repo{Id: "repo1"}, plan, "", []v1.Hook_Condition{event}, HookVars{Error: "cancelled"})
+	}
+
+	statuses := make(map[v1.Hook_Condition]v1.OperationStatus)
+	if _, err := log.Query(oplog.Query{}, func(op *v1.Operation) error {
+		statuses[op.GetOperationRunHook().GetCondition()] = op.Status
+		return nil
+	}); err != nil {
+		t.Fatalf("failed to query operations: %v", err)
+	}
+	if got := statuses[v1.Hook_CONDITION_SNAPSHOT_START]; got != v1.OperationStatus_STATUS_ERROR {
+		t.Errorf("want the start hook cancelled with the operation, got status %v", got)
+	}
+	if got := statuses[v1.Hook_CONDITION_SNAPSHOT_ERROR]; got != v1.OperationStatus_STATUS_SUCCESS {
+		t.Errorf("want the error hook to run, got status %v", got)
+	}
+}
+
+func TestExecuteHooksAsync(t *testing.T) {
+	log := oplog.NewMemStore()
+	executor := NewHookExecutor(log, rotatinglog.NewRotatingLog(t.TempDir(), 10))
+	plan := &v1.Plan{Id: "plan1", Hooks: []*v1.Hook{{
+		Conditions: []v1.Hook_Condition{v1.Hook_CONDITION_SNAPSHOT_START},
+		OnError:    v1.Hook_ON_ERROR_FATAL,
+		Async:      true,
+		Action:     &v1.Hook_ActionCommand{ActionCommand: &v1.Hook_Command{Command: "sleep 0.2; exit 1"}},
+	}}}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	if err := executor.ExecuteHooks(ctx, &v1.Repo{Id: "repo1"}, plan, "", []v1.Hook_Condition{v1.Hook_CONDITION_SNAPSHOT_START}, HookVars{}); err != nil {
+		t.Fatalf("async hook failures must not fail the operation, got %v", err)
+	}
+	cancel() // async hooks outlive the operation that triggered them.
+	executor.Wait(context.Background())
+
+	var statuses []v1.OperationStatus
+	if _, err := log.Query(oplog.Query{}, func(op *v1.Operation) error {
+		statuses = append(statuses, op.Status)
+		return nil
+	}); err != nil {
+		t.Fatalf("failed to query operations: %v", err)
+	}
+	if len(statuses) != 1 || statuses[0] != v1.OperationStatus_STATUS_ERROR {
+		t.Errorf("want the hook recorded as failed, got statuses %v", statuses)
+	}
+}
+
+func TestExecuteHooksGlobal(t *testing.T) {
+	command := func(name string) *v1.Hook {
+		return &v1.Hook{
+			Name:       name,
+			Conditions: []v1.Hook_Condition{v1.Hook_CONDITION_SNAPSHOT_START},
+			Action:     &v1.Hook_ActionCommand{ActionCommand: &v1.Hook_Command{Command: "exit 0"}},
+		}
+	}
+
+	log := oplog.NewMemStore()
+	executor := NewHookExecutor(log, rotatinglog.NewRotatingLog(t.TempDir(), 10))
+	executor.SetGlobalHooks([]*v1.Hook{command("alert"), command("skipped-by-repo"), command("skipped-by-plan"), command("")})
+	repo := &v1.Repo{Id: "repo1", Hooks: []*v1.Hook{command("")}, SkipGlobalHooks: []string{"skipped-by-repo"}}
+	plan := &v1.Plan{Id: "plan1", Hooks: []*v1.Hook{command("")}, SkipGlobalHooks: []string{"skipped-by-plan"}}
+
+	if err := executor.ExecuteHooks(context.Background(), repo, plan, "", []v1.Hook_Condition{v1.Hook_CONDITION_SNAPSHOT_START}, HookVars{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var names []string
+	if _, err := log.Query(oplog.Query{}, func(op *v1.Operation) error {
+		names = append(names, op.GetOperationRunHook().GetName())
+		return nil
+	}); err != nil {
+		t.Fatalf("failed to query operations: %v", err)
+	}
+	want := []string{"repo/repo1/hook/0", "plan/plan1/hook/0", "global/hook/alert", "global/hook/3"}
+	if !slices.Equal(names, want) {
+		t.Errorf("want hooks %v, got %v", want, names)
+	}
+}
+
+func TestExecuteHooksNotificationPolicy(t *testing.T) {
+	log := oplog.NewMemStore()
+	executor := NewHookExecutor(log, rotatinglog.NewRotatingLog(t.TempDir(), 10))
+	outFile := path.Join(t.TempDir(), "out.txt")
+	repo := &v1.Repo{Id: "repo1"}
+	plan := &v1.Plan{Id: "plan1", Hooks: []*v1.Hook{
+		{
+			Conditions:         []v1.Hook_Condition{v1.Hook_CONDITION_SNAPSHOT_END},
+			NotificationPolicy: &v1.NotificationPolicy{OnChangeOnly: true, Digest: true},
+			Action:             &v1.Hook_ActionCommand{ActionCommand: &v1.Hook_Command{Command: "echo '{{ .Recovered }} {{ len .Suppressed }}' >> " + outFile}},
+		},
+		{
+			Conditions:         []v1.Hook_Condition{v1.Hook_CONDITION_ANY_ERROR},
+			NotificationPolicy: &v1.NotificationPolicy{MaxPerPeriod: 1},
+			Action:             &v1.Hook_ActionCommand{ActionCommand: &v1.Hook_Command{Command: "exit 0"}},
+		},
+	}}
+
+	for _, failed := range []bool{true, true, true, false, false} {
+		op := &v1.Operation{
+			RepoId: "repo1",
+			PlanId: "plan1",
+			Status: v1.OperationStatus_STATUS_INPROGRESS,
+			Op:     &v1.Operation_OperationBackup{OperationBackup: &v1.OperationBackup{}},
+		}
+		if err := log.Add(op); err != nil {
+			t.Fatalf("error adding operation: %s", err)
+		}
+		vars := HookVars{OperationId: op.Id}
+		events := []v1.Hook_Condition{v1.Hook_CONDITION_SNAPSHOT_END}
+		op.Status = v1.OperationStatus_STATUS_SUCCESS
+		if failed {
+			vars.Error = "backup failed"
+			events = append(events, v1.Hook_CONDITION_ANY_ERROR)
+			op.Status = v1.OperationStatus_STATUS_ERROR
+		}
+		if err := executor.ExecuteHooks(context.Background(), repo, plan, "", events, vars); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if err := log.Update(op); err != nil {
+			t.Fatalf("error updating operation: %s", err)
+		}
+	}
+
+	var suppressed []string
+	if _, err := log.Query(oplog.Query{Types: []v1.OperationType{v1.OperationType_TYPE_RUN_HOOK}}, func(op *v1.Operation) error {
+		if op.GetOperationRunHook().GetSuppressed() {
+			suppressed = append(suppressed, op.GetOperationRunHook().GetName()+": "+op.DisplayMessage)
+		}
+		return nil
+	}); err != nil {
+		t.Fatalf("failed to query operations: %v", err)
+	}
+	wantSuppressed := []string{
+		"plan/plan1/hook/0: suppressed by notification policy: the previous operation also failed",
+		"plan/plan1/hook/1: suppressed by notification policy: ran 1 times in the last 60 minutes",
+		"plan/plan1/hook/0: suppressed by notification policy: the previous operation also failed",
+		"plan/plan1/hook/1: suppressed by notification policy: ran 1 times in the last 60 minutes",
+		"plan/plan1/hook/0: suppressed by notification policy: the previous operation also succeeded",
+	}
+	if !slices.Equal(suppressed, wantSuppressed) {
+		t.Errorf("want suppressed runs %q, got %q", wantSuppressed, suppressed)
+	}
+
+	// the hook ran for the first failure and for the recovery, with a digest of the failures in between.
+	out, err := os.ReadFile(outFile)
+	if err != nil {
+		t.Fatalf("failed to read hook output: %v", err)
+	}
+	if want := "false 0\ntrue 2\n"; string(out) != want {
+		t.Errorf("want hook output %q, got %q", want, string(out))
+	}
+}
+
+func TestExecuteHooksNotificationPolicyPartialBackups(t *testing.T) {
+	log := oplog.NewMemStore()
+	executor := NewHookExecutor(log, rotatinglog.NewRotatingLog(t.TempDir(), 10))
+	outFile := path.Join(t.TempDir(), "out.txt")
+	repo := &v1.Repo{Id: "repo1"}
+	plan := &v1.Plan{Id: "plan1", Hooks: []*v1.Hook{
+		{
+			Conditions:         []v1.Hook_Condition{v1.Hook_CONDITION_SNAPSHOT_END},
+			NotificationPolicy: &v1.NotificationPolicy{OnChangeOnly: true},
+			Action:             &v1.Hook_ActionCommand{ActionCommand: &v1.Hook_Command{Command: "echo '{{ .Error }}' >> " + outFile}},
+		},
+	}}
+
+	// a success followed by two partial backups, the partial backups report an error and are recorded with a warning.
+	for _, partial := range []bool{false, true, true} {
+		op := &v1.Operation{
+			RepoId: "repo1",
+			PlanId: "plan1",
+			Status: v1.OperationStatus_STATUS_INPROGRESS,
+			Op:     &v1.Operation_OperationBackup{OperationBackup: &v1.OperationBackup{}},
+		}
+		if err := log.Add(op); err != nil {
+			t.Fatalf("error adding operation: %s", err)
+		}
+		vars := HookVars{OperationId: op.Id}
+		op.Status = v1.OperationStatus_STATUS_SUCCESS
+		if partial {
+			vars.Error = "incomplete backup"
+			op.Status = v1.OperationStatus_STATUS_WARNING
+		}
+		if err := executor.ExecuteHooks(context.Background(), repo, plan, "", []v1.Hook_Condition{v1.Hook_CONDITION_SNAPSHOT_END}, vars); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if err := log.Update(op); err != nil {
+			t.Fatalf("error updating operation: %s", err)
+		}
+	}
+
+	var suppressed []string
+	if _, err := log.Query(oplog.Query{Types: []v1.OperationType{v1.OperationType_TYPE_RUN_HOOK}}, func(op *v1.Operation) error {
+		if op.GetOperationRunHook().GetSuppressed() {
+			suppressed = append(suppressed, op.DisplayMessage)
+		}
+		return nil
+	}); err != nil {
+		t.Fatalf("failed to query operations: %v", err)
+	}
+	if want := []string{"suppressed by notification policy: the previous operation also failed"}; !slices.Equal(suppressed, want) {
+		t.Errorf("want suppressed runs %q, got %q", want, suppressed)
+	}
+
+	// the hook ran for the success and for the first partial backup only.
+	out, err := os.ReadFile(outFile)
+	if err != nil {
+		t.Fatalf("failed to read hook output: %v", err)
+	}
+	if want := "\nincomplete backup\n"; string(out) != want {
+		t.Errorf("want hook output %q, got %q", want, string(out))
+	}
+}
+
+func TestTestHook(t *testing.T) {
+	log := oplog.NewMemStore()
+	executor := NewHookExecutor(log, rotatinglog.NewRotatingLog(t.TempDir(), 10))
+	for _, op := range []*v1.Operation{
+		{
+			PlanId: "plan1",
+			RepoId: "repo1",
+			Status: v1.OperationStatus_STATUS_SUCCESS,
+			Op: &v1.Operation_OperationBackup{OperationBackup: &v1.OperationBackup{
+				LastStatus: &v1.BackupProgressEntry{Entry: &v1.BackupProgressEntry_Summary{Summary: &v1.BackupProgressSummary{SnapshotId: "abcdef", FilesNew: 3}}},
+			}},
+		},
+		{
+			PlanId:         "plan1",
+			RepoId:         "repo1",
+			Status:         v1.OperationStatus_STATUS_ERROR,
+			DisplayMessage: "repo is locked",
+			Op:             &v1.Operation_OperationBackup{OperationBackup: &v1.OperationBackup{}},
+		},
+	} {
+		if err := log.Add(op); err != nil {
+			t.Fatalf("error adding operation: %s", err)
+		}
+	}
+
+	vars := executor.SampleVars(&v1.Repo{Id: "repo1"}, &v1.Plan{Id: "plan1", Repo: "repo1"}, v1.Hook_CONDITION_SNAPSHOT_ERROR)
+	if vars.SnapshotId != "abcdef" || vars.SnapshotStats.FilesNew != 3 || vars.Error != "repo is locked" {
+		t.Errorf("want vars from the latest backups, got snapshot %q, stats %+v, error %q", vars.SnapshotId, vars.SnapshotStats, vars.Error)
+	}
+
+	hook := &v1.Hook{Action: &v1.Hook_ActionCommand{ActionCommand: &v1.Hook_Command{Command: "echo {{ .SnapshotId }} {{ .ShellEscape .Error }}"}}}
+	rendered, output, err := executor.TestHook(context.Background(), hook, v1.Hook_CONDITION_SNAPSHOT_ERROR, vars)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := "echo abcdef 'repo is locked'"; rendered != want {
+		t.Errorf("want rendered %q, got %q", want, rendered)
+	}
+	if !strings.Contains(output, "abcdef repo is locked\n") {
+		t.Errorf("want output to contain the command's output, got %q", output)
+	}
+
+	hook.Action = &v1.Hook_ActionDiscord{ActionDiscord: &v1.Hook_Discord{WebhookUrl: "http://127.0.0.1:0", Template: "{{ .Missing }}"}}
+	if _, _, err := executor.TestHook(context.Background(), hook, v1.Hook_CONDITION_SNAPSHOT_ERROR, vars); err == nil || !strings.Contains(err.Error(), "template rendering") {
+		t.Errorf("want a template rendering error, got %v", err)
+	}
+
+	if _, err := log.Query(oplog.Query{Types: []v1.OperationType{v1.OperationType_TYPE_RUN_HOOK}}, func(op *v1.Operation) error {
+		t.Errorf("test runs must not be recorded, got operation %v", op)
+		return nil
+	}); err != nil {
+		t.Fatalf("failed to query operations: %v", err)
+	}
+}
+
+func TestHookEmail(t *testing.T) {
+	server := helpers.NewSMTPServer(t)
+
+	log := oplog.NewMemStore()
+	logStore := rotatinglog.NewRotatingLog(t.TempDir(), 10)
+	executor := NewHookExecutor(log, logStore)
+	logref, err := logStore.Write([]byte("restic output"))
+	if err != nil {
+		t.Fatalf("failed to write log: %v", err)
+	}
+	op := &v1.Operation{RepoId: "repo1", PlanId: "plan1", Status: v1.OperationStatus_STATUS_ERROR, Logref: logref, Op: &v1.Operation_OperationBackup{}}
+	if err := log.Add(op); err != nil {
+		t.Fatalf("error adding operation: %s", err)
+	}
+
+	plan := &v1.Plan{Id: "plan1", Hooks: []*v1.Hook{{
+		Conditions: []v1.Hook_Condition{v1.Hook_CONDITION_SNAPSHOT_ERROR},
+		OnError:    v1.Hook_ON_ERROR_FATAL,
+		Action: &v1.Hook_ActionEmail{ActionEmail: &v1.Hook_Email{
+			Host:      server.Host,
+			Port:      int32(server.Port),
+			Username:  "user",
+			Password:  "pass",
+			From:      "Backrest <backrest@example.com>",
+			To:        []string{"ops@example.com"},
+			Cc:        []string{"oncall@example.com"},
+			Template:  "<b>{{ .Error }}</b>",
+			Html:      true,
+			AttachLog: true,
+		}},
+	}}}
+	if err := executor.ExecuteHooks(context.Background(), &v1.Repo{Id: "repo1"}, plan, "", []v1.Hook_Condition{v1.Hook_CONDITION_SNAPSHOT_ERROR}, HookVars{
+		Error:       "repo is locked",
+		OperationId: op.Id,
+	}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	<-server.Done
+
+	if want := []string{"TO:<ops@example.com>", "TO:<oncall@example.com>"}; !slices.Equal(server.Recipients, want) {
+		t.Errorf("want recipients %v, got %v", want, server.Recipients)
+	}
+	if server.Auth == "" {
+		t.Errorf("want the client to authenticate")
+	}
+	for _, want := range []string{
+		"Subject: Backrest snapshot error for plan plan1",
+		"Cc: oncall@example.com",
+		"Content-Type: text/html; charset=utf-8",
+		"<b>repo is locked</b>",
+		`filename=operation.log`,
+		base64.StdEncoding.EncodeToString([]byte("restic output")),
+	} {
+		if !strings.Contains(server.Data, want) {
+			t.Errorf("want message to contain %q, got:\n%s", want, server.Data)
+		}
+	}
+}
+
+func TestHookHealthcheck(t *testing.T) {
+	type ping struct {
+		method string
+		uri    string
+		body   string
+	}
+	var pings []ping
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		pings = append(pings, ping{r.Method, r.URL.RequestURI(), string(body)})
+		w.Write([]byte("OK"))
+	}))
+	t.Cleanup(server.Close)
+
+	log := oplog.NewMemStore()
+	logStore := rotatinglog.NewRotatingLog(t.TempDir(), 10)
+	executor := NewHookExecutor(log, logStore)
+	logref, err := logStore.Write([]byte("restic output"))
+	if err != nil {
+		t.Fatalf("failed to write log: %v", err)
+	}
+	op := &v1.Operation{RepoId: "repo1", PlanId: "plan1", Status: v1.OperationStatus_STATUS_ERROR, Logref: logref, Op: &v1.Operation_OperationBackup{}}
+	if err := log.Add(op); err != nil {
+		t.Fatalf("error adding operation: %s", err)
+	}
+
+	conditions := []v1.Hook_Condition{v1.Hook_CONDITION_SNAPSHOT_START, v1.Hook_CONDITION_SNAPSHOT_END, v1.Hook_CONDITION_SNAPSHOT_ERROR}
+	plan := &v1.Plan{Id: "plan1", Hooks: []*v1.Hook{
+		{
+			Conditions: conditions,
+			OnError:    v1.Hook_ON_ERROR_FATAL,
+			Action:     &v1.Hook_ActionHealthcheck{ActionHealthcheck: &v1.Hook_Healthcheck{PingUrl: server.URL + "/ping/uuid"}},
+		},
+		{
+			Conditions: conditions,
+			OnError:    v1.Hook_ON_ERROR_FATAL,
+			Action: &v1.Hook_ActionHealthcheck{ActionHealthcheck: &v1.Hook_Healthcheck{
+				PingUrl:       server.URL + "/api/push/token",
+				StartSuffix:   "?status=up&msg=started",
+				SuccessSuffix: "?status=up&msg=OK",
+				FailSuffix:    "?status=down&msg=failed",
+				Method:        v1.Hook_Webhook_GET,
+			}},
+		},
+	}}
+
+	for _, tc := range []struct {
+		event v1.Hook_Condition
+		vars  HookVars
+	}{
+		{event: v1.Hook_CONDITION_SNAPSHOT_START},
+		{event: v1.Hook_CONDITION_SNAPSHOT_END},
+		{event: v1.Hook_CONDITION_SNAPSHOT_ERROR, vars: HookVars{Error: "repo is locked", OperationId: op.Id}},
+	} {
+		if err := executor.ExecuteHooks(context.Background(), &v1.Repo{Id: "repo1"}, plan, "", []v1.Hook_Condition{tc.event}, tc.vars); err != nil {
+			t.Fatalf("unexpected error for %v: %v", tc.event, err)
+		}
+	}
+
+	want := []ping{
+		{method: "POST", uri: "/ping/uuid/start"},
+		{method: "GET", uri: "/api/push/token?status=up&msg=started"},
+		{method: "POST", uri: "/ping/uuid"},
+		{method: "GET", uri: "/api/push/token?status=up&msg=OK"},
+		{method: "POST", uri: "/ping/uuid/fail"},
+		{method: "GET", uri: "/api/push/token?status=down&msg=failed"},
+	}
+	if len(pings) != len(want) {
+		t.Fatalf("want %d pings, got %+v", len(want), pings)
+	}
+	for i, p := range pings {
+		if p.method != want[i].method || p.uri != want[i].uri {
+			t.Errorf("ping %d: want %s %s, got %s %s", i, want[i].method, want[i].uri, p.method, p.uri)
+		}
+	}
+	if body := pings[4].body; !strings.Contains(body, "repo is locked") || !strings.HasSuffix(body, "---- log ----\nrestic output") {
+		t.Errorf("want the fail ping to carry the error and the log, got %q", body)
+	}
+}
+
+func TestHealthcheckBody(t *testing.T) {
+	if got, want := healthcheckBody("summary", []byte("0123456789"), 30), "summary\n---- log ----\n...\n6789"; got != want {
+		t.Errorf("want %q, got %q", want, got)
+	}
+	if got, want := healthcheckBody("summary", nil, 30), "summary"; got != want {
+		t.Errorf("want %q, got %q", want, got)
+	}
+	if got, want := healthcheckBody("summary", []byte("log"), 5), "summa"; got != want {
+		t.Errorf("want %q, got %q", want, got)
+	}
+}
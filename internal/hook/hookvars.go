@@ -22,8 +22,27 @@ type HookVars struct {
 	Plan          *v1.Plan                    // the v1.Plan that triggered the hook.
 	SnapshotId    string                      // the snapshot ID that triggered the hook.
 	SnapshotStats *restic.BackupProgressEntry // the summary of the backup operation.
+	PruneStats    *restic.PruneStats          // the statistics of the prune operation.
+	Forgotten     []*v1.ResticSnapshot        // the snapshots removed by the forget operation.
+	RestorePath   string                      // the path within the snapshot being restored.
+	RestoreTarget string                      // the directory the restore writes to.
+	RestoreStats  *v1.RestoreProgressEntry    // the summary of the restore operation.
+	RepoStats     *v1.RepoStats               // the statistics collected by the stats operation.
 	CurTime       time.Time                   // the current time as time.Time
 	Error         string                      // the error that caused the hook to run as a string.
+	ErrorKind     string                      // the classification of the error e.g. "wrong password" or "repo is locked", empty if unknown.
+	OperationId   int64                       // the id of the operation that triggered the hook, 0 if unknown.
+	Recovered     bool                        // the operation succeeded and the previous operation of the plan and type failed.
+	Suppressed    []SuppressedEvent           // the runs of the hook its notification policy suppressed since it last ran, if it asks for a digest.
+
+	operationLog []byte // the log of the operation that triggered the hook, loaded for hooks that send it.
+}
+
+// SuppressedEvent is a run of a hook suppressed by its notification policy.
+type SuppressedEvent struct {
+	Event  v1.Hook_Condition // the event the hook would have run for.
+	Time   time.Time         // when the event happened.
+	Reason string            // why the run was suppressed.
 }
 
 func (v HookVars) EventName(cond v1.Hook_Condition) string {
@@ -36,6 +55,32 @@ func (v HookVars) EventName(cond v1.Hook_Condition) string {
 		return "error"
 	case v1.Hook_CONDITION_SNAPSHOT_ERROR:
 		return "snapshot error"
+	case v1.Hook_CONDITION_ANY_SUCCESS:
+		return "success"
+	case v1.Hook_CONDITION_PRUNE_START:
+		return "prune start"
+	case v1.Hook_CONDITION_PRUNE_SUCCESS:
+		return "prune success"
+	case v1.Hook_CONDITION_PRUNE_ERROR:
+		return "prune error"
+	case v1.Hook_CONDITION_FORGET_START:
+		return "forget start"
+	case v1.Hook_CONDITION_FORGET_SUCCESS:
+		return "forget success"
+	case v1.Hook_CONDITION_FORGET_ERROR:
+		return "forget error"
+	case v1.Hook_CONDITION_RESTORE_START:
+		return "restore start"
+	case v1.Hook_CONDITION_RESTORE_SUCCESS:
+		return "restore success"
+	case v1.Hook_CONDITION_RESTORE_ERROR:
+		return "restore error"
+	case v1.Hook_CONDITION_STATS_START:
+		return "stats start"
+	case v1.Hook_CONDITION_STATS_SUCCESS:
+		return "stats success"
+	case v1.Hook_CONDITION_STATS_ERROR:
+		return "stats error"
 	default:
 		return "unknown"
 	}
@@ -72,7 +117,13 @@ func (v HookVars) FormatSizeBytes(val any) string {
 }
 
 func (v HookVars) IsError(cond v1.Hook_Condition) bool {
-	return cond == v1.Hook_CONDITION_ANY_ERROR || cond == v1.Hook_CONDITION_SNAPSHOT_ERROR
+	switch cond {
+	case v1.Hook_CONDITION_ANY_ERROR, v1.Hook_CONDITION_SNAPSHOT_ERROR, v1.Hook_CONDITION_PRUNE_ERROR,
+		v1.Hook_CONDITION_FORGET_ERROR, v1.Hook_CONDITION_RESTORE_ERROR, v1.Hook_CONDITION_STATS_ERROR:
+		return true
+	default:
+		return false
+	}
 }
 
 func (v HookVars) ShellEscape(s string) string {
@@ -88,6 +139,18 @@ func (v HookVars) JsonMarshal(s any) string {
 }
 
 func (v HookVars) Summary() (string, error) {
+	summary, err := v.summaryForEvent()
+	if err != nil || len(v.Suppressed) == 0 {
+		return summary, err
+	}
+	digest, err := v.renderTemplate(templateForSuppressed)
+	if err != nil {
+		return "", err
+	}
+	return summary + digest, nil
+}
+
+func (v HookVars) summaryForEvent() (string, error) {
 	switch v.Event {
 	case v1.Hook_CONDITION_SNAPSHOT_START:
 		return v.renderTemplate(templateForSnapshotStart)
@@ -97,6 +160,12 @@ func (v HookVars) Summary() (string, error) {
 		return v.renderTemplate(templateForError)
 	case v1.Hook_CONDITION_SNAPSHOT_ERROR:
 		return v.renderTemplate(templateForError)
+	case v1.Hook_CONDITION_PRUNE_START, v1.Hook_CONDITION_FORGET_START, v1.Hook_CONDITION_RESTORE_START, v1.Hook_CONDITION_STATS_START:
+		return v.renderTemplate(templateForStart)
+	case v1.Hook_CONDITION_PRUNE_SUCCESS, v1.Hook_CONDITION_FORGET_SUCCESS, v1.Hook_CONDITION_RESTORE_SUCCESS, v1.Hook_CONDITION_STATS_SUCCESS, v1.Hook_CONDITION_ANY_SUCCESS:
+		return v.renderTemplate(templateForSuccess)
+	case v1.Hook_CONDITION_PRUNE_ERROR, v1.Hook_CONDITION_FORGET_ERROR, v1.Hook_CONDITION_RESTORE_ERROR, v1.Hook_CONDITION_STATS_ERROR:
+		return v.renderTemplate(templateForError)
 	default:
 		return "unknown event", nil
 	}
@@ -126,6 +195,9 @@ Snapshot: {{ .SnapshotId }}
 {{ if .Error -}}
 Failed to create snapshot: {{ .Error }}
 {{ else -}}
+{{ if .Recovered -}}
+Recovered: the previous backup failed.
+{{ end -}}
 {{ if .SnapshotStats -}}
 
 Overview:
@@ -149,6 +221,9 @@ Backup Statistics:
 var templateForError = `
 Backrest Notification for Error
 Task: "{{ .Task }}" at {{ .FormatTime .CurTime }}
+{{ if .ErrorKind -}}
+Cause: {{ .ErrorKind }}
+{{ end -}}
 {{ if .Error -}}
 Error: {{ .Error }}
 {{ end }}`
@@ -163,3 +238,47 @@ Paths:
 {{ range .Plan.Paths -}}
  - {{ . }}
 {{ end }}`
+
+var templateForStart = `
+Backrest Notification for {{ .EventName .Event }}
+Task: "{{ .Task }}" at {{ .FormatTime .CurTime }}
+{{ if .Repo -}}
+Repo: {{ .Repo.Id }}
+{{ end -}}
+{{ if .RestoreTarget -}}
+Restoring: {{ .SnapshotId }}:{{ .RestorePath }} to {{ .RestoreTarget }}
+{{ end }}`
+
+var templateForSuccess = `
+Backrest Notification for {{ .EventName .Event }}
+Task: "{{ .Task }}" at {{ .FormatTime .CurTime }}
+{{ if .Repo -}}
+Repo: {{ .Repo.Id }}
+{{ end -}}
+{{ if .Recovered -}}
+Recovered: the previous operation failed.
+{{ end -}}
+{{ if .PruneStats -}}
+Prune: removed {{ .FormatSizeBytes .PruneStats.BytesRemoved }}, {{ .FormatSizeBytes .PruneStats.BytesRemaining }} remaining
+{{ end -}}
+{{ if .Forgotten -}}
+Forgot snapshots:
+{{ range .Forgotten -}}
+ - {{ .Id }}
+{{ end -}}
+{{ end -}}
+{{ if .RestoreTarget -}}
+Restored: {{ .SnapshotId }}:{{ .RestorePath }} to {{ .RestoreTarget }}
+{{ end -}}
+{{ if .RestoreStats -}}
+Restore: {{ .RestoreStats.FilesRestored }} files, {{ .FormatSizeBytes .RestoreStats.BytesRestored }}
+{{ end -}}
+{{ if .RepoStats -}}
+Repo size: {{ .FormatSizeBytes .RepoStats.TotalSize }} in {{ .RepoStats.SnapshotCount }} snapshots
+{{ end }}`
+
+var templateForSuppressed = `
+Suppressed since the last notification:
+{{ range .Suppressed -}}
+ - {{ $.EventName .Event }} at {{ $.FormatTime .Time }}: {{ .Reason }}
+{{ end }}`
@@ -1,22 +1,32 @@
 package hook
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"net/http"
+	"time"
 )
 
-func post(url string, contentType string, body io.Reader) (string, error) {
-	r, err := http.Post(url, contentType, body)
+// httpClient is used by the notification hooks, the timeout bounds requests to endpoints that never respond.
+var httpClient = &http.Client{Timeout: 30 * time.Second}
+
+func post(ctx context.Context, url string, contentType string, body io.Reader) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, body)
+	if err != nil {
+		return "", fmt.Errorf("create request %v: %w", url, err)
+	}
+	req.Header.Set("Content-Type", contentType)
+	r, err := httpClient.Do(req)
 	if err != nil {
 		return "", fmt.Errorf("send request %v: %w", url, err)
 	}
+	defer r.Body.Close()
 	if r.StatusCode == 204 {
 		return "", nil
 	} else if r.StatusCode != 200 {
 		return "", fmt.Errorf("unexpected status %v: %s", r.StatusCode, r.Status)
 	}
-	defer r.Body.Close()
 	bodyBytes, err := io.ReadAll(r.Body)
 	if err != nil {
 		return "", fmt.Errorf("read response: %w", err)
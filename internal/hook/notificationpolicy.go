@@ -0,0 +1,146 @@
+package hook
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+	"github.com/garethgeorge/backrest/internal/oplog"
+)
+
+var defaultNotificationPeriod = 60 * time.Minute
+
+// outcomeTypes are the types of the operations whose outcomes are reported by hook events.
+var outcomeTypes = []v1.OperationType{
+	v1.OperationType_TYPE_BACKUP,
+	v1.OperationType_TYPE_FORGET,
+	v1.OperationType_TYPE_PRUNE,
+	v1.OperationType_TYPE_RESTORE,
+	v1.OperationType_TYPE_STATS,
+}
+
+// eventOutcome returns whether the event reports a failed operation, ok is false for events that don't report an outcome.
+func eventOutcome(event v1.Hook_Condition, vars HookVars) (failed bool, ok bool) {
+	switch event {
+	case v1.Hook_CONDITION_SNAPSHOT_END:
+		return vars.Error != "", true
+	case v1.Hook_CONDITION_ANY_SUCCESS, v1.Hook_CONDITION_PRUNE_SUCCESS, v1.Hook_CONDITION_FORGET_SUCCESS,
+		v1.Hook_CONDITION_RESTORE_SUCCESS, v1.Hook_CONDITION_STATS_SUCCESS:
+		return false, true
+	default:
+		return vars.IsError(event), vars.IsError(event)
+	}
+}
+
+// eventOperationTypes returns the types of the operations that report the event.
+func eventOperationTypes(event v1.Hook_Condition) []v1.OperationType {
+	switch event {
+	case v1.Hook_CONDITION_SNAPSHOT_START, v1.Hook_CONDITION_SNAPSHOT_END, v1.Hook_CONDITION_SNAPSHOT_ERROR:
+		return []v1.OperationType{v1.OperationType_TYPE_BACKUP}
+	case v1.Hook_CONDITION_PRUNE_START, v1.Hook_CONDITION_PRUNE_SUCCESS, v1.Hook_CONDITION_PRUNE_ERROR:
+		return []v1.OperationType{v1.OperationType_TYPE_PRUNE}
+	case v1.Hook_CONDITION_FORGET_START, v1.Hook_CONDITION_FORGET_SUCCESS, v1.Hook_CONDITION_FORGET_ERROR:
+		return []v1.OperationType{v1.OperationType_TYPE_FORGET}
+	case v1.Hook_CONDITION_RESTORE_START, v1.Hook_CONDITION_RESTORE_SUCCESS, v1.Hook_CONDITION_RESTORE_ERROR:
+		return []v1.OperationType{v1.OperationType_TYPE_RESTORE}
+	case v1.Hook_CONDITION_STATS_START, v1.Hook_CONDITION_STATS_SUCCESS, v1.Hook_CONDITION_STATS_ERROR:
+		return []v1.OperationType{v1.OperationType_TYPE_STATS}
+	default:
+		return outcomeTypes
+	}
+}
+
+// scopeQuery returns a query for the operations of the plan, or of the repo if the hooks don't run for a plan.
+func scopeQuery(vars HookVars) oplog.Query {
+	if vars.Plan.GetId() != "" {
+		return oplog.Query{PlanId: vars.Plan.GetId()}
+	}
+	return oplog.Query{RepoId: vars.Repo.GetId()}
+}
+
+// previousFailed returns whether the most recent completed operation of the type reporting the event failed, found is false
+// if there is no such operation. The operation that triggered the hooks is ignored. Partial backups, recorded with STATUS_WARNING,
+// count as failed as their events report an error.
+func (e *HookExecutor) previousFailed(event v1.Hook_Condition, vars HookVars) (failed bool, found bool, err error) {
+	q := scopeQuery(vars)
+	q.Types = eventOperationTypes(event)
+	q.Statuses = []v1.OperationStatus{v1.OperationStatus_STATUS_SUCCESS, v1.OperationStatus_STATUS_WARNING, v1.OperationStatus_STATUS_ERROR}
+	q.Reverse = true
+	_, err = e.oplog.Query(q, func(op *v1.Operation) error {
+		if op.Id == vars.OperationId {
+			return nil
+		}
+		failed, found = op.Status == v1.OperationStatus_STATUS_ERROR || op.Status == v1.OperationStatus_STATUS_WARNING, true
+		return oplog.ErrStopIteration
+	})
+	return failed, found, err
+}
+
+// notificationPolicyHelper returns why the policy suppresses the hook's run for the event, or an empty string if the hook
+// should run.
+func (e *HookExecutor) notificationPolicyHelper(policy *v1.NotificationPolicy, name string, event v1.Hook_Condition, vars HookVars) (string, error) {
+	if policy.GetOnChangeOnly() {
+		if failed, ok := eventOutcome(event, vars); ok {
+			prevFailed, found, err := e.previousFailed(event, vars)
+			if err != nil {
+				return "", fmt.Errorf("query previous outcome: %w", err)
+			}
+			if found && prevFailed == failed {
+				if failed {
+					return "the previous operation also failed", nil
+				}
+				return "the previous operation also succeeded", nil
+			}
+		}
+	}
+
+	if policy.GetMaxPerPeriod() > 0 {
+		period := defaultNotificationPeriod
+		if policy.GetPeriodMinutes() > 0 {
+			period = time.Duration(policy.GetPeriodMinutes()) * time.Minute
+		}
+		q := scopeQuery(vars)
+		q.Types = []v1.OperationType{v1.OperationType_TYPE_RUN_HOOK}
+		q.StartTimeMs = vars.CurTime.Add(-period).UnixMilli()
+		count := 0
+		if _, err := e.oplog.Query(q, func(op *v1.Operation) error {
+			if hookOp := op.GetOperationRunHook(); hookOp.GetName() == name && !hookOp.GetSuppressed() {
+				count++
+			}
+			return nil
+		}); err != nil {
+			return "", fmt.Errorf("query recent runs: %w", err)
+		}
+		if count >= int(policy.GetMaxPerPeriod()) {
+			return fmt.Sprintf("ran %d times in the last %v minutes", count, int(period.Minutes())), nil
+		}
+	}
+	return "", nil
+}
+
+// suppressedSince returns the runs of the hook suppressed since it last ran, oldest first.
+func (e *HookExecutor) suppressedSince(name string, vars HookVars) ([]SuppressedEvent, error) {
+	q := scopeQuery(vars)
+	q.Types = []v1.OperationType{v1.OperationType_TYPE_RUN_HOOK}
+	q.Reverse = true
+	var suppressed []SuppressedEvent
+	if _, err := e.oplog.Query(q, func(op *v1.Operation) error {
+		hookOp := op.GetOperationRunHook()
+		if hookOp.GetName() != name {
+			return nil
+		} else if !hookOp.GetSuppressed() {
+			return oplog.ErrStopIteration
+		}
+		suppressed = append(suppressed, SuppressedEvent{
+			Event:  hookOp.GetCondition(),
+			Time:   time.UnixMilli(op.UnixTimeStartMs),
+			Reason: op.DisplayMessage,
+		})
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	slices.Reverse(suppressed)
+	return suppressed, nil
+}
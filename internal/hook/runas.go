@@ -0,0 +1,37 @@
+//go:build linux || darwin || freebsd
+// +build linux darwin freebsd
+
+package hook
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"os/user"
+	"strconv"
+	"syscall"
+)
+
+// setRunAsUser runs the command as the named user and hands the files the command reads over to the user.
+func setRunAsUser(cmd *exec.Cmd, username string, files ...string) error {
+	u, err := user.Lookup(username)
+	if err != nil {
+		return err
+	}
+	uid, err := strconv.ParseUint(u.Uid, 10, 32)
+	if err != nil {
+		return fmt.Errorf("parse uid %q: %w", u.Uid, err)
+	}
+	gid, err := strconv.ParseUint(u.Gid, 10, 32)
+	if err != nil {
+		return fmt.Errorf("parse gid %q: %w", u.Gid, err)
+	}
+	for _, file := range files {
+		if err := os.Chown(file, int(uid), int(gid)); err != nil {
+			return err
+		}
+	}
+	cmd.SysProcAttr = &syscall.SysProcAttr{Credential: &syscall.Credential{Uid: uint32(uid), Gid: uint32(gid)}}
+	cmd.Env = append(cmd.Env, "HOME="+u.HomeDir, "USER="+u.Username)
+	return nil
+}
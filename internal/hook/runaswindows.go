@@ -0,0 +1,14 @@
+//go:build windows
+// +build windows
+
+package hook
+
+import (
+	"errors"
+	"os/exec"
+)
+
+// setRunAsUser is not supported on windows, running a command as another user requires their credentials.
+func setRunAsUser(cmd *exec.Cmd, username string, files ...string) error {
+	return errors.New("not supported on windows")
+}
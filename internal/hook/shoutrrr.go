@@ -1,6 +1,7 @@
 package hook
 
 import (
+	"context"
 	"fmt"
 	"io"
 
@@ -8,7 +9,7 @@ import (
 	v1 "github.com/garethgeorge/backrest/gen/go/v1"
 )
 
-func (h *Hook) doShoutrrr(cmd *v1.Hook_ActionShoutrrr, vars HookVars, output io.Writer) error {
+func (h *Hook) doShoutrrr(ctx context.Context, cmd *v1.Hook_ActionShoutrrr, vars HookVars, output io.Writer) error {
 	payload, err := h.renderTemplateOrDefault(cmd.ActionShoutrrr.GetTemplate(), defaultTemplate, vars)
 	if err != nil {
 		return fmt.Errorf("template rendering: %w", err)
@@ -17,8 +18,18 @@ func (h *Hook) doShoutrrr(cmd *v1.Hook_ActionShoutrrr, vars HookVars, output io.
 	fmt.Fprintf(output, "Sending notification to %s\nContents:\n", cmd.ActionShoutrrr.GetShoutrrrUrl())
 	output.Write([]byte(payload))
 
-	if err := shoutrrr.Send(cmd.ActionShoutrrr.GetShoutrrrUrl(), payload); err != nil {
-		return fmt.Errorf("send notification to %q: %w", cmd.ActionShoutrrr.GetShoutrrrUrl(), err)
+	// shoutrrr doesn't take a context, stop waiting on it when the hook is cancelled.
+	done := make(chan error, 1)
+	go func() {
+		done <- shoutrrr.Send(cmd.ActionShoutrrr.GetShoutrrrUrl(), payload)
+	}()
+	select {
+	case err := <-done:
+		if err != nil {
+			return fmt.Errorf("send notification to %q: %w", cmd.ActionShoutrrr.GetShoutrrrUrl(), err)
+		}
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("send notification to %q: %w", cmd.ActionShoutrrr.GetShoutrrrUrl(), ctx.Err())
 	}
-	return nil
 }
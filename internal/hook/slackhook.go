@@ -2,6 +2,7 @@ package hook
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -9,7 +10,7 @@ import (
 	v1 "github.com/garethgeorge/backrest/gen/go/v1"
 )
 
-func (h *Hook) doSlack(cmd *v1.Hook_ActionSlack, vars HookVars, output io.Writer) error {
+func (h *Hook) doSlack(ctx context.Context, cmd *v1.Hook_ActionSlack, vars HookVars, output io.Writer) error {
 	payload, err := h.renderTemplateOrDefault(cmd.ActionSlack.GetTemplate(), defaultTemplate, vars)
 	if err != nil {
 		return fmt.Errorf("template rendering: %w", err)
@@ -28,6 +29,6 @@ func (h *Hook) doSlack(cmd *v1.Hook_ActionSlack, vars HookVars, output io.Writer
 	fmt.Fprintf(output, "Sending Slack message to %s\n---- payload ----\n", cmd.ActionSlack.GetWebhookUrl())
 	output.Write(requestBytes)
 
-	_, err = post(cmd.ActionSlack.GetWebhookUrl(), "application/json", bytes.NewReader(requestBytes))
+	_, err = post(ctx, cmd.ActionSlack.GetWebhookUrl(), "application/json", bytes.NewReader(requestBytes))
 	return err
 }
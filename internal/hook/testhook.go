@@ -0,0 +1,142 @@
+package hook
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+	"github.com/garethgeorge/backrest/internal/protoutil"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+)
+
+var defaultTestHookTimeout = 1 * time.Minute
+
+// SampleVars returns the variables a hook would see for the event, each taken from the most recent completed operation of the
+// plan that reports it, or of the repo if plan is nil. Variables no recent operation reports are left empty, except for the error
+// which is made up for error events.
+func (e *HookExecutor) SampleVars(repo *v1.Repo, plan *v1.Plan, event v1.Hook_Condition) HookVars {
+	vars := HookVars{
+		Task:    "test hook",
+		Event:   event,
+		Repo:    repo,
+		Plan:    plan,
+		CurTime: time.Now(),
+	}
+
+	q := scopeQuery(vars)
+	q.Types = outcomeTypes
+	q.Statuses = []v1.OperationStatus{v1.OperationStatus_STATUS_SUCCESS, v1.OperationStatus_STATUS_WARNING, v1.OperationStatus_STATUS_ERROR}
+	q.Reverse = true
+	q.Limit = 100
+	if _, err := e.oplog.Query(q, func(op *v1.Operation) error {
+		switch op := op.Op.(type) {
+		case *v1.Operation_OperationBackup:
+			if summary := op.OperationBackup.GetLastStatus().GetSummary(); summary != nil && vars.SnapshotStats == nil {
+				vars.SnapshotStats = protoutil.BackupProgressSummaryFromProto(summary)
+				vars.SnapshotId = summary.SnapshotId
+			}
+		case *v1.Operation_OperationForget:
+			if vars.Forgotten == nil {
+				vars.Forgotten = op.OperationForget.Forget
+			}
+		case *v1.Operation_OperationPrune:
+			if stats := op.OperationPrune.GetStats(); stats != nil && vars.PruneStats == nil {
+				vars.PruneStats = protoutil.PruneStatsFromProto(stats)
+			}
+		case *v1.Operation_OperationRestore:
+			if vars.RestoreTarget == "" {
+				vars.RestorePath = op.OperationRestore.Path
+				vars.RestoreTarget = op.OperationRestore.Target
+				vars.RestoreStats = op.OperationRestore.Status
+			}
+		case *v1.Operation_OperationStats:
+			if vars.RepoStats == nil {
+				vars.RepoStats = op.OperationStats.Stats
+			}
+		}
+		if vars.Error == "" && op.Status == v1.OperationStatus_STATUS_ERROR && slices.Contains(eventOperationTypes(event), protoutil.OperationType(op)) {
+			vars.Error = op.DisplayMessage
+		}
+		return nil
+	}); err != nil {
+		zap.S().Errorf("sample hook vars: query operations: %v", err)
+	}
+
+	if vars.IsError(event) && vars.Error == "" {
+		vars.Error = "this is a test error, no operation failed"
+	}
+	return vars
+}
+
+// TestHook runs the hook's action once for the event with vars, returning the rendered action and the action's output. Unlike
+// ExecuteHooks the run is not recorded in the operation log, and the hook's notification policy, retries and async are ignored.
+func (e *HookExecutor) TestHook(ctx context.Context, hook *v1.Hook, event v1.Hook_Condition, vars HookVars) (rendered string, output string, err error) {
+	h := (*Hook)(hook)
+	vars.Event = event
+
+	rendered, err = h.render(vars)
+	if err != nil {
+		return "", "", fmt.Errorf("template rendering: %w", err)
+	}
+
+	timeout := defaultTestHookTimeout
+	if hook.TimeoutSeconds > 0 {
+		timeout = time.Duration(hook.TimeoutSeconds) * time.Second
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	// run the action for the event whatever the hook's conditions are.
+	testHook := proto.Clone(hook).(*v1.Hook)
+	testHook.Conditions = []v1.Hook_Condition{event}
+	buf := &bytes.Buffer{}
+	err = (*Hook)(testHook).Do(ctx, event, vars, buf)
+	return rendered, buf.String(), err
+}
+
+// render returns the hook's action rendered with vars as it would be run or sent, e.g. the command or the message.
+func (h *Hook) render(vars HookVars) (string, error) {
+	switch action := h.Action.(type) {
+	case *v1.Hook_ActionCommand:
+		return h.renderTemplate(action.ActionCommand.Command, vars)
+	case *v1.Hook_ActionWebhook:
+		if action.ActionWebhook.GetMethod() == v1.Hook_Webhook_GET {
+			return "", nil
+		}
+		return h.renderTemplateOrDefault(action.ActionWebhook.GetTemplate(), defaultTemplate, vars)
+	case *v1.Hook_ActionDiscord:
+		return h.renderTemplateOrDefault(action.ActionDiscord.GetTemplate(), defaultTemplate, vars)
+	case *v1.Hook_ActionGotify:
+		title, err := h.renderTemplateOrDefault(action.ActionGotify.GetTitleTemplate(), "Backrest Event", vars)
+		if err != nil {
+			return "", fmt.Errorf("title: %w", err)
+		}
+		message, err := h.renderTemplateOrDefault(action.ActionGotify.GetTemplate(), defaultTemplate, vars)
+		if err != nil {
+			return "", err
+		}
+		return title + "\n\n" + message, nil
+	case *v1.Hook_ActionSlack:
+		return h.renderTemplateOrDefault(action.ActionSlack.GetTemplate(), defaultTemplate, vars)
+	case *v1.Hook_ActionShoutrrr:
+		return h.renderTemplateOrDefault(action.ActionShoutrrr.GetTemplate(), defaultTemplate, vars)
+	case *v1.Hook_ActionEmail:
+		subject, err := h.renderTemplateOrDefault(action.ActionEmail.GetSubjectTemplate(), defaultSubject, vars)
+		if err != nil {
+			return "", fmt.Errorf("subject: %w", err)
+		}
+		body, err := h.renderTemplateOrDefault(action.ActionEmail.GetTemplate(), defaultTemplate, vars)
+		if err != nil {
+			return "", err
+		}
+		return "Subject: " + subject + "\n\n" + body, nil
+	case *v1.Hook_ActionHealthcheck:
+		return action.ActionHealthcheck.GetPingUrl() + healthcheckSuffix(action.ActionHealthcheck, vars), nil
+	default:
+		return "", fmt.Errorf("unknown hook action: %v", action)
+	}
+}
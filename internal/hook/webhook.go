@@ -0,0 +1,136 @@
+package hook
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"time"
+
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+)
+
+var (
+	defaultWebhookTimeout = 30 * time.Second
+)
+
+func (h *Hook) doWebhook(ctx context.Context, cmd *v1.Hook_ActionWebhook, vars HookVars, output io.Writer) error {
+	webhook := cmd.ActionWebhook
+
+	method := http.MethodPost
+	switch webhook.GetMethod() {
+	case v1.Hook_Webhook_GET:
+		method = http.MethodGet
+	case v1.Hook_Webhook_PUT:
+		method = http.MethodPut
+	case v1.Hook_Webhook_PATCH:
+		method = http.MethodPatch
+	}
+
+	var payload string
+	if method != http.MethodGet {
+		var err error
+		payload, err = h.renderTemplateOrDefault(webhook.GetTemplate(), defaultTemplate, vars)
+		if err != nil {
+			return fmt.Errorf("template rendering: %w", err)
+		}
+	}
+
+	client, err := webhookClient(ctx, webhook)
+	if err != nil {
+		return &permanentError{err}
+	}
+
+	fmt.Fprintf(output, "Sending webhook %s %s\n", method, webhook.GetWebhookUrl())
+	if payload != "" {
+		fmt.Fprintf(output, "---- payload ----\n%s\n", payload)
+	}
+
+	retry, err := sendWebhook(ctx, client, webhook, method, payload, output)
+	if err != nil && !retry {
+		return &permanentError{err}
+	}
+	return err
+}
+
+// webhookClient returns a client applying the webhook's TLS options. Requests time out with ctx or, if ctx has no deadline, after
+// defaultWebhookTimeout.
+func webhookClient(ctx context.Context, webhook *v1.Hook_Webhook) (*http.Client, error) {
+	var timeout time.Duration
+	if _, ok := ctx.Deadline(); !ok {
+		timeout = defaultWebhookTimeout
+	}
+
+	tlsConfig := &tls.Config{InsecureSkipVerify: webhook.GetSkipTlsVerify()}
+	if webhook.GetCaCertPath() != "" {
+		pem, err := os.ReadFile(webhook.GetCaCertPath())
+		if err != nil {
+			return nil, fmt.Errorf("read ca cert: %w", err)
+		}
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificates found in ca cert %q", webhook.GetCaCertPath())
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig
+	return &http.Client{Timeout: timeout, Transport: transport}, nil
+}
+
+// sendWebhook makes a single attempt at the request, returning whether a failure is worth retrying.
+func sendWebhook(ctx context.Context, client *http.Client, webhook *v1.Hook_Webhook, method, payload string, output io.Writer) (bool, error) {
+	var body io.Reader
+	if method != http.MethodGet {
+		body = bytes.NewReader([]byte(payload))
+	}
+	req, err := http.NewRequestWithContext(ctx, method, webhook.GetWebhookUrl(), body)
+	if err != nil {
+		return false, fmt.Errorf("create request: %w", err)
+	}
+	if body != nil {
+		contentType := webhook.GetContentType()
+		if contentType == "" {
+			contentType = "text/plain"
+		}
+		req.Header.Set("Content-Type", contentType)
+	}
+	for k, v := range webhook.GetHeaders() {
+		req.Header.Set(k, v)
+	}
+	switch auth := webhook.GetAuth().(type) {
+	case *v1.Hook_Webhook_BasicAuth_:
+		req.SetBasicAuth(auth.BasicAuth.GetUsername(), auth.BasicAuth.GetPassword())
+	case *v1.Hook_Webhook_BearerToken:
+		req.Header.Set("Authorization", "Bearer "+auth.BearerToken)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		var tlsErr *tls.CertificateVerificationError
+		return !errors.As(err, &tlsErr) && ctx.Err() == nil, fmt.Errorf("send request %v: %w", webhook.GetWebhookUrl(), err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return true, fmt.Errorf("read response: %w", err)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		retry := resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500
+		return retry, fmt.Errorf("unexpected status %v: %s", resp.StatusCode, respBody)
+	}
+	if len(respBody) > 0 {
+		fmt.Fprintf(output, "---- response ----\n%s\n", respBody)
+	}
+	return false, nil
+}
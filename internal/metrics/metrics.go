@@ -0,0 +1,372 @@
+// Package metrics maintains daily and weekly rollups of each plan's backup and stats operations.
+package metrics
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"time"
+
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+	"github.com/garethgeorge/backrest/internal/oplog"
+	"github.com/garethgeorge/backrest/internal/protoutil"
+	bolt "go.etcd.io/bbolt"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+)
+
+var (
+	bucketsBucket = []byte("metrics.buckets") // plan id, 0, bucket size, start time -> MetricsBucket
+	samplesBucket = []byte("metrics.samples") // operation id -> sample
+	stateBucket   = []byte("metrics.state")
+
+	lastSeqKey = []byte("last_seq") // seq of the last oplog event applied to the rollups.
+)
+
+// eventBufferSize is the number of oplog events buffered while the rollups are updated before the store resyncs.
+const eventBufferSize = 1024
+
+// Store keeps the rollups in a bbolt database next to the operation log. It follows the log's events and records each completed
+// backup and stats operation as a sample that is added to the buckets covering its start time. Rollups outlive the operations
+// they summarize, deleting an operation e.g. by garbage collection leaves its buckets untouched.
+type Store struct {
+	db  *bolt.DB
+	log oplog.OpLog
+}
+
+func NewStore(databasePath string, log oplog.OpLog) (*Store, error) {
+	if err := os.MkdirAll(path.Dir(databasePath), 0700); err != nil {
+		return nil, fmt.Errorf("error creating database directory: %s", err)
+	}
+
+	db, err := bolt.Open(databasePath, 0600, &bolt.Options{Timeout: 1 * time.Second})
+	if err != nil {
+		return nil, fmt.Errorf("error opening database: %s", err)
+	}
+
+	if err := db.Update(func(tx *bolt.Tx) error {
+		for _, bucket := range [][]byte{bucketsBucket, samplesBucket, stateBucket} {
+			if _, err := tx.CreateBucketIfNotExists(bucket); err != nil {
+				return fmt.Errorf("creating bucket %s: %s", string(bucket), err)
+			}
+		}
+		return nil
+	}); err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	return &Store{db: db, log: log}, nil
+}
+
+func (s *Store) Close() error {
+	return s.db.Close()
+}
+
+// Run applies the operation log's events to the rollups until the context is done. Events journaled while backrest wasn't
+// following the log are replayed first, if they are no longer available the rollups are rebuilt from the operations in the log.
+func (s *Store) Run(ctx context.Context) {
+	sub := s.log.Subscribe(eventBufferSize, oplog.OverflowResync)
+	defer sub.Close()
+
+	if err := s.catchUp(); err != nil {
+		zap.L().Error("failed to catch up metrics with the operation log", zap.Error(err))
+	}
+
+	for {
+		event, err := sub.Next(ctx)
+		if err != nil {
+			if ctx.Err() == nil {
+				zap.L().Error("metrics stopped following the operation log", zap.Error(err))
+			}
+			return
+		}
+		if err := s.apply(event); err != nil {
+			zap.L().Error("failed to update metrics", zap.Int64("seq", event.Seq), zap.Error(err))
+		}
+	}
+}
+
+// catchUp applies the events journaled since the last applied event, or rebuilds the rollups if there is no record of one.
+func (s *Store) catchUp() error {
+	var lastSeq int64
+	var found bool
+	if err := s.db.View(func(tx *bolt.Tx) error {
+		lastSeq, found = getLastSeq(tx)
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	events, seq, err := s.log.EventsSince(lastSeq)
+	if !found || errors.Is(err, oplog.ErrResyncRequired) {
+		return s.backfill(seq)
+	} else if err != nil {
+		return fmt.Errorf("replaying events since %d: %w", lastSeq, err)
+	}
+	for _, event := range events {
+		if err := s.apply(event); err != nil {
+			return fmt.Errorf("applying event %d: %w", event.Seq, err)
+		}
+	}
+	return nil
+}
+
+// backfill records a sample for every completed backup and stats operation in the log and marks seq as applied. Recording is
+// idempotent so events racing with the backfill are safe to apply again.
+func (s *Store) backfill(seq int64) error {
+	var ops []*v1.Operation
+	if _, err := s.log.Query(oplog.Query{Types: []v1.OperationType{v1.OperationType_TYPE_BACKUP, v1.OperationType_TYPE_STATS}}, func(op *v1.Operation) error {
+		if newSample(op) != nil {
+			ops = append(ops, op)
+		}
+		return nil
+	}); err != nil {
+		return fmt.Errorf("querying operations: %w", err)
+	}
+
+	if err := s.db.Update(func(tx *bolt.Tx) error {
+		for _, op := range ops {
+			if err := recordHelper(tx, op); err != nil {
+				return err
+			}
+		}
+		return putLastSeq(tx, seq)
+	}); err != nil {
+		return fmt.Errorf("recording operations: %w", err)
+	}
+	zap.L().Info("rebuilt metrics from the operation log", zap.Int("operations", len(ops)))
+	return nil
+}
+
+// apply updates the rollups for an event, events at or before the last applied seq are ignored.
+func (s *Store) apply(event *v1.OperationEvent) error {
+	if event.Transient {
+		return nil
+	}
+	if event.Type == v1.OperationEventType_EVENT_RESYNC_REQUIRED {
+		return s.backfill(event.Seq)
+	}
+
+	return s.db.Update(func(tx *bolt.Tx) error {
+		if lastSeq, _ := getLastSeq(tx); event.Seq <= lastSeq {
+			return nil
+		}
+		switch event.Type {
+		case v1.OperationEventType_EVENT_CREATED, v1.OperationEventType_EVENT_UPDATED:
+			if err := recordHelper(tx, event.Operation); err != nil {
+				return err
+			}
+		case v1.OperationEventType_EVENT_DELETED:
+			// the buckets keep the operation's contribution, only the sample used to revise it is dropped.
+			if err := tx.Bucket(samplesBucket).Delete(idKey(event.Operation.Id)); err != nil {
+				return err
+			}
+		}
+		return putLastSeq(tx, event.Seq)
+	})
+}
+
+// PlanMetrics returns the plan's buckets of the given size that start within [startMs, endMs), endMs of 0 means no limit.
+func (s *Store) PlanMetrics(planId string, size v1.MetricsBucketSize, startMs, endMs int64) (*v1.PlanMetrics, error) {
+	if size == v1.MetricsBucketSize_BUCKET_SIZE_UNKNOWN {
+		size = v1.MetricsBucketSize_BUCKET_SIZE_DAY
+	}
+	if _, ok := bucketDuration[size]; !ok {
+		return nil, fmt.Errorf("unsupported bucket size %v", size)
+	}
+
+	metrics := &v1.PlanMetrics{PlanId: planId, BucketSize: size}
+	prefix := bucketPrefix(planId, size)
+	if err := s.db.View(func(tx *bolt.Tx) error {
+		c := tx.Bucket(bucketsBucket).Cursor()
+		for k, v := c.Seek(bucketKey(planId, size, startMs)); k != nil && bytes.HasPrefix(k, prefix); k, v = c.Next() {
+			if endMs != 0 && btoi(k[len(prefix):]) >= endMs {
+				break
+			}
+			var bucket v1.MetricsBucket
+			if err := proto.Unmarshal(v, &bucket); err != nil {
+				return fmt.Errorf("unmarshal bucket: %w", err)
+			}
+			metrics.Buckets = append(metrics.Buckets, &bucket)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return metrics, nil
+}
+
+// sample is the contribution of a single operation to the buckets covering its start time. Samples are kept so that an operation
+// that is updated after it completed, or recorded twice, replaces its previous contribution rather than adding to it.
+type sample struct {
+	PlanId        string `json:"planId"`
+	StartMs       int64  `json:"startMs"`
+	Succeeded     int64  `json:"succeeded,omitempty"`
+	Failed        int64  `json:"failed,omitempty"`
+	DurationMs    int64  `json:"durationMs,omitempty"`
+	DataAdded     int64  `json:"dataAdded,omitempty"`
+	FilesNew      int64  `json:"filesNew,omitempty"`
+	FilesChanged  int64  `json:"filesChanged,omitempty"`
+	RepoSizeBytes int64  `json:"repoSizeBytes,omitempty"`
+}
+
+// newSample returns the sample for a completed backup or a successful stats operation, otherwise nil.
+func newSample(op *v1.Operation) *sample {
+	if op.PlanId == "" {
+		return nil
+	}
+	s := &sample{PlanId: op.PlanId, StartMs: op.UnixTimeStartMs}
+	switch protoutil.OperationType(op) {
+	case v1.OperationType_TYPE_BACKUP:
+		switch op.Status {
+		case v1.OperationStatus_STATUS_SUCCESS, v1.OperationStatus_STATUS_WARNING:
+			s.Succeeded = 1
+		case v1.OperationStatus_STATUS_ERROR:
+			s.Failed = 1
+		default:
+			return nil
+		}
+		if op.UnixTimeEndMs > op.UnixTimeStartMs {
+			s.DurationMs = op.UnixTimeEndMs - op.UnixTimeStartMs
+		}
+		if summary := op.GetOperationBackup().GetLastStatus().GetSummary(); summary != nil {
+			s.DataAdded = summary.DataAdded
+			s.FilesNew = summary.FilesNew
+			s.FilesChanged = summary.FilesChanged
+		}
+	case v1.OperationType_TYPE_STATS:
+		stats := op.GetOperationStats().GetStats()
+		if op.Status != v1.OperationStatus_STATUS_SUCCESS || stats == nil {
+			return nil
+		}
+		s.RepoSizeBytes = stats.TotalSize
+	default:
+		return nil
+	}
+	return s
+}
+
+// recordHelper replaces the operation's previous sample, if any, with its current one.
+func recordHelper(tx *bolt.Tx, op *v1.Operation) error {
+	samples := tx.Bucket(samplesBucket)
+	key := idKey(op.Id)
+	if v := samples.Get(key); v != nil {
+		var old sample
+		if err := json.Unmarshal(v, &old); err != nil {
+			return fmt.Errorf("unmarshal sample for opid %v: %w", op.Id, err)
+		}
+		if err := addHelper(tx, &old, -1); err != nil {
+			return err
+		}
+	}
+
+	s := newSample(op)
+	if s == nil {
+		return samples.Delete(key)
+	}
+	if err := addHelper(tx, s, 1); err != nil {
+		return err
+	}
+	v, err := json.Marshal(s)
+	if err != nil {
+		return fmt.Errorf("marshal sample for opid %v: %w", op.Id, err)
+	}
+	return samples.Put(key, v)
+}
+
+// addHelper adds (sign 1) or removes (sign -1) the sample from the day and week buckets covering its start time. The repo size is
+// a gauge, it is set by the latest sample in the bucket and left as is when a sample is removed.
+func addHelper(tx *bolt.Tx, s *sample, sign int64) error {
+	b := tx.Bucket(bucketsBucket)
+	for size := range bucketDuration {
+		start := bucketStart(size, s.StartMs)
+		key := bucketKey(s.PlanId, size, start)
+		bucket := &v1.MetricsBucket{StartTimeMs: start}
+		if v := b.Get(key); v != nil {
+			if err := proto.Unmarshal(v, bucket); err != nil {
+				return fmt.Errorf("unmarshal bucket: %w", err)
+			}
+		}
+
+		bucket.BackupsSucceeded += sign * s.Succeeded
+		bucket.BackupsFailed += sign * s.Failed
+		bucket.TotalDurationMs += sign * s.DurationMs
+		bucket.DataAddedBytes += sign * s.DataAdded
+		bucket.FilesNew += sign * s.FilesNew
+		bucket.FilesChanged += sign * s.FilesChanged
+		if sign > 0 && s.RepoSizeBytes != 0 && s.StartMs >= bucket.RepoSizeTimeMs {
+			bucket.RepoSizeBytes = s.RepoSizeBytes
+			bucket.RepoSizeTimeMs = s.StartMs
+		}
+
+		if bucket.BackupsSucceeded == 0 && bucket.BackupsFailed == 0 && bucket.RepoSizeBytes == 0 {
+			if err := b.Delete(key); err != nil {
+				return err
+			}
+			continue
+		}
+		v, err := proto.Marshal(bucket)
+		if err != nil {
+			return fmt.Errorf("marshal bucket: %w", err)
+		}
+		if err := b.Put(key, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+var bucketDuration = map[v1.MetricsBucketSize]time.Duration{
+	v1.MetricsBucketSize_BUCKET_SIZE_DAY:  24 * time.Hour,
+	v1.MetricsBucketSize_BUCKET_SIZE_WEEK: 7 * 24 * time.Hour,
+}
+
+// bucketStart returns the start of the UTC day, or of the week starting on Monday, containing unixTimeMs.
+func bucketStart(size v1.MetricsBucketSize, unixTimeMs int64) int64 {
+	day := time.UnixMilli(unixTimeMs).UTC().Truncate(24 * time.Hour)
+	if size == v1.MetricsBucketSize_BUCKET_SIZE_WEEK {
+		day = day.AddDate(0, 0, -((int(day.Weekday()) + 6) % 7))
+	}
+	return day.UnixMilli()
+}
+
+func bucketPrefix(planId string, size v1.MetricsBucketSize) []byte {
+	return append([]byte(planId), 0, byte(size))
+}
+
+func bucketKey(planId string, size v1.MetricsBucketSize, startMs int64) []byte {
+	return append(bucketPrefix(planId, size), itob(startMs)...)
+}
+
+func idKey(id int64) []byte {
+	return itob(id)
+}
+
+func getLastSeq(tx *bolt.Tx) (int64, bool) {
+	v := tx.Bucket(stateBucket).Get(lastSeqKey)
+	if v == nil {
+		return 0, false
+	}
+	return btoi(v), true
+}
+
+func putLastSeq(tx *bolt.Tx, seq int64) error {
+	return tx.Bucket(stateBucket).Put(lastSeqKey, itob(seq))
+}
+
+// itob encodes v big endian with the sign bit flipped so that keys sort in numeric order, including times before the epoch.
+func itob(v int64) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, uint64(v)^(1<<63))
+	return b
+}
+
+func btoi(b []byte) int64 {
+	return int64(binary.BigEndian.Uint64(b) ^ (1 << 63))
+}
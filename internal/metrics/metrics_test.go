@@ -0,0 +1,199 @@
+package metrics
+
+import (
+	"testing"
+	"time"
+
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+	"github.com/garethgeorge/backrest/internal/oplog"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestBucketStart(t *testing.T) {
+	t.Parallel()
+
+	ts := time.Date(2024, 3, 7, 15, 30, 0, 0, time.UTC).UnixMilli() // a Thursday.
+	if got, want := bucketStart(v1.MetricsBucketSize_BUCKET_SIZE_DAY, ts), time.Date(2024, 3, 7, 0, 0, 0, 0, time.UTC).UnixMilli(); got != want {
+		t.Errorf("day bucket: want %v, got %v", want, got)
+	}
+	if got, want := bucketStart(v1.MetricsBucketSize_BUCKET_SIZE_WEEK, ts), time.Date(2024, 3, 4, 0, 0, 0, 0, time.UTC).UnixMilli(); got != want {
+		t.Errorf("week bucket: want %v, got %v", want, got)
+	}
+}
+
+func TestRollups(t *testing.T) {
+	t.Parallel()
+
+	log := oplog.NewMemStore()
+	store, err := NewStore(t.TempDir()+"/metrics.boltdb", log)
+	if err != nil {
+		t.Fatalf("error creating metrics store: %s", err)
+	}
+	t.Cleanup(func() { store.Close() })
+
+	day := func(d int, hour int) int64 {
+		return time.Date(2024, 3, d, hour, 0, 0, 0, time.UTC).UnixMilli()
+	}
+	backup := func(d int, status v1.OperationStatus, dataAdded int64) *v1.Operation {
+		return &v1.Operation{
+			PlanId:          "plan1",
+			RepoId:          "repo1",
+			UnixTimeStartMs: day(d, 1),
+			UnixTimeEndMs:   day(d, 1) + 1000,
+			Status:          status,
+			Op: &v1.Operation_OperationBackup{OperationBackup: &v1.OperationBackup{
+				LastStatus: &v1.BackupProgressEntry{Entry: &v1.BackupProgressEntry_Summary{Summary: &v1.BackupProgressSummary{
+					DataAdded:    dataAdded,
+					FilesNew:     1,
+					FilesChanged: 2,
+				}}},
+			}},
+		}
+	}
+	stats := func(d int, hour int, size int64) *v1.Operation {
+		return &v1.Operation{
+			PlanId:          "plan1",
+			RepoId:          "repo1",
+			UnixTimeStartMs: day(d, hour),
+			Status:          v1.OperationStatus_STATUS_SUCCESS,
+			Op:              &v1.Operation_OperationStats{OperationStats: &v1.OperationStats{Stats: &v1.RepoStats{TotalSize: size}}},
+		}
+	}
+
+	// operations that exist before the store first catches up are backfilled.
+	for _, op := range []*v1.Operation{
+		backup(4, v1.OperationStatus_STATUS_SUCCESS, 100),
+		backup(4, v1.OperationStatus_STATUS_ERROR, 0),
+		stats(4, 2, 1000),
+		stats(4, 3, 2000),
+		backup(5, v1.OperationStatus_STATUS_INPROGRESS, 0),
+	} {
+		if err := log.Add(op); err != nil {
+			t.Fatalf("error adding operation: %s", err)
+		}
+	}
+	if err := store.catchUp(); err != nil {
+		t.Fatalf("error catching up: %s", err)
+	}
+
+	// later changes are applied from the journal.
+	inProgress := backup(5, v1.OperationStatus_STATUS_SUCCESS, 50)
+	if err := log.Add(inProgress); err != nil {
+		t.Fatalf("error adding operation: %s", err)
+	}
+	for _, op := range []*v1.Operation{
+		backup(11, v1.OperationStatus_STATUS_SUCCESS, 10),
+	} {
+		if err := log.Add(op); err != nil {
+			t.Fatalf("error adding operation: %s", err)
+		}
+	}
+	inProgress.Status = v1.OperationStatus_STATUS_WARNING // an update replaces the operation's contribution.
+	if err := log.Update(inProgress); err != nil {
+		t.Fatalf("error updating operation: %s", err)
+	}
+	if err := log.Delete(inProgress.Id); err != nil { // deleting an operation keeps its contribution.
+		t.Fatalf("error deleting operation: %s", err)
+	}
+	if err := store.catchUp(); err != nil {
+		t.Fatalf("error catching up: %s", err)
+	}
+	// applying an event again has no effect.
+	if err := store.apply(&v1.OperationEvent{Type: v1.OperationEventType_EVENT_CREATED, Operation: backup(11, v1.OperationStatus_STATUS_SUCCESS, 10), Seq: 1}); err != nil {
+		t.Fatalf("error applying event: %s", err)
+	}
+
+	tcs := []struct {
+		name    string
+		size    v1.MetricsBucketSize
+		start   int64
+		end     int64
+		buckets []*v1.MetricsBucket
+	}{
+		{
+			name: "days",
+			size: v1.MetricsBucketSize_BUCKET_SIZE_DAY,
+			buckets: []*v1.MetricsBucket{
+				{StartTimeMs: day(4, 0), BackupsSucceeded: 1, BackupsFailed: 1, TotalDurationMs: 2000, DataAddedBytes: 100, FilesNew: 2, FilesChanged: 4, RepoSizeBytes: 2000, RepoSizeTimeMs: day(4, 3)},
+				{StartTimeMs: day(5, 0), BackupsSucceeded: 1, TotalDurationMs: 1000, DataAddedBytes: 50, FilesNew: 1, FilesChanged: 2},
+				{StartTimeMs: day(11, 0), BackupsSucceeded: 1, TotalDurationMs: 1000, DataAddedBytes: 10, FilesNew: 1, FilesChanged: 2},
+			},
+		},
+		{
+			name:  "days in range",
+			size:  v1.MetricsBucketSize_BUCKET_SIZE_DAY,
+			start: day(5, 0),
+			end:   day(11, 0),
+			buckets: []*v1.MetricsBucket{
+				{StartTimeMs: day(5, 0), BackupsSucceeded: 1, TotalDurationMs: 1000, DataAddedBytes: 50, FilesNew: 1, FilesChanged: 2},
+			},
+		},
+		{
+			name: "weeks",
+			size: v1.MetricsBucketSize_BUCKET_SIZE_WEEK,
+			buckets: []*v1.MetricsBucket{
+				{StartTimeMs: day(4, 0), BackupsSucceeded: 2, BackupsFailed: 1, TotalDurationMs: 3000, DataAddedBytes: 150, FilesNew: 3, FilesChanged: 6, RepoSizeBytes: 2000, RepoSizeTimeMs: day(4, 3)},
+				{StartTimeMs: day(11, 0), BackupsSucceeded: 1, TotalDurationMs: 1000, DataAddedBytes: 10, FilesNew: 1, FilesChanged: 2},
+			},
+		},
+	}
+
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			metrics, err := store.PlanMetrics("plan1", tc.size, tc.start, tc.end)
+			if err != nil {
+				t.Fatalf("error getting metrics: %s", err)
+			}
+			if len(metrics.Buckets) != len(tc.buckets) {
+				t.Fatalf("want %d buckets, got %v", len(tc.buckets), metrics.Buckets)
+			}
+			for i, want := range tc.buckets {
+				if !proto.Equal(metrics.Buckets[i], want) {
+					t.Errorf("bucket %d: want %v, got %v", i, want, metrics.Buckets[i])
+				}
+			}
+		})
+	}
+
+	if metrics, err := store.PlanMetrics("plan2", v1.MetricsBucketSize_BUCKET_SIZE_DAY, 0, 0); err != nil || len(metrics.Buckets) != 0 {
+		t.Errorf("want no buckets for plan2, got %v, err: %v", metrics, err)
+	}
+}
+
+func TestRollupsResync(t *testing.T) {
+	t.Parallel()
+
+	log := oplog.NewMemStore()
+	store, err := NewStore(t.TempDir()+"/metrics.boltdb", log)
+	if err != nil {
+		t.Fatalf("error creating metrics store: %s", err)
+	}
+	t.Cleanup(func() { store.Close() })
+
+	op := &v1.Operation{
+		PlanId:          "plan1",
+		RepoId:          "repo1",
+		UnixTimeStartMs: time.Date(2024, 3, 4, 1, 0, 0, 0, time.UTC).UnixMilli(),
+		Status:          v1.OperationStatus_STATUS_SUCCESS,
+		Op:              &v1.Operation_OperationBackup{OperationBackup: &v1.OperationBackup{}},
+	}
+	if err := log.Add(op); err != nil {
+		t.Fatalf("error adding operation: %s", err)
+	}
+	if err := store.catchUp(); err != nil {
+		t.Fatalf("error catching up: %s", err)
+	}
+	// a resync rebuilds from the log without counting recorded operations twice.
+	if err := store.apply(&v1.OperationEvent{Type: v1.OperationEventType_EVENT_RESYNC_REQUIRED, Seq: 1}); err != nil {
+		t.Fatalf("error applying resync: %s", err)
+	}
+
+	metrics, err := store.PlanMetrics("plan1", v1.MetricsBucketSize_BUCKET_SIZE_DAY, 0, 0)
+	if err != nil {
+		t.Fatalf("error getting metrics: %s", err)
+	}
+	if len(metrics.Buckets) != 1 || metrics.Buckets[0].BackupsSucceeded != 1 {
+		t.Errorf("want a single bucket with one backup, got %v", metrics.Buckets)
+	}
+}
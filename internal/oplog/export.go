@@ -0,0 +1,202 @@
+package oplog
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+	"github.com/garethgeorge/backrest/internal/rotatinglog"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// exportBatchSize is the number of operations read or written per transaction, the log isn't locked while a batch is handed to the caller.
+const exportBatchSize = 256
+
+// Export calls do for each operation matching the query ordered by id. The query's cursor, limit and ordering are ignored.
+// If logs is set the logs referenced by each operation are embedded in the export, logs that have rotated out are omitted.
+func Export(log OpLog, q Query, logs *rotatinglog.RotatingLog, do func(op *v1.ExportedOperation) error) error {
+	q.Cursor = 0
+	q.Limit = exportBatchSize
+	q.Reverse = false
+
+	for {
+		var batch []*v1.Operation
+		cursor, err := log.Query(q, func(op *v1.Operation) error {
+			batch = append(batch, op)
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, op := range batch {
+			exported := &v1.ExportedOperation{Operation: op}
+			if logs != nil {
+				if exported.Logs, err = readLogrefs(logs, op); err != nil {
+					return fmt.Errorf("read logs of operation %v: %w", op.Id, err)
+				}
+			}
+			if err := do(exported); err != nil {
+				return err
+			}
+		}
+
+		if cursor == 0 {
+			return nil
+		}
+		q.Cursor = cursor
+	}
+}
+
+// ExportTo writes the operations matching the query to w as newline delimited protojson, see Export.
+func ExportTo(w io.Writer, log OpLog, q Query, logs *rotatinglog.RotatingLog) (int, error) {
+	bw := bufio.NewWriter(w)
+	count := 0
+	if err := Export(log, q, logs, func(op *v1.ExportedOperation) error {
+		bytes, err := protojson.Marshal(op)
+		if err != nil {
+			return fmt.Errorf("marshal operation %v: %w", op.Operation.GetId(), err)
+		}
+		if _, err := bw.Write(append(bytes, '\n')); err != nil {
+			return err
+		}
+		count++
+		return nil
+	}); err != nil {
+		return count, err
+	}
+	return count, bw.Flush()
+}
+
+// Import adds the operations returned by next to the log until it returns io.EOF. Operations get new ids derived from
+// their start time so they keep their order relative to the existing history, and references between imported operations
+// are rewritten to the new ids. Operations that were pending or in progress when exported are skipped. If logs is set the
+// logs embedded in the export are written to it and the operations' logrefs re-linked to the new entries, otherwise logrefs
+// are kept as is e.g. for when the log directory was copied along with the export. Import does not deduplicate, importing
+// the same export twice adds its operations twice. Returns the number of operations imported.
+func Import(log OpLog, next func() (*v1.ExportedOperation, error), logs *rotatinglog.RotatingLog) (int, error) {
+	idMap := make(map[int64]int64)
+	var forgotByOps []int64 // imported operations referencing a forget operation by its exported id.
+	count := 0
+
+	for done := false; !done; {
+		var batch []*v1.Operation
+		for len(batch) < exportBatchSize {
+			exported, err := next()
+			if errors.Is(err, io.EOF) {
+				done = true
+				break
+			} else if err != nil {
+				return count, err
+			}
+
+			op := exported.GetOperation()
+			if op == nil || op.Status == v1.OperationStatus_STATUS_PENDING || op.Status == v1.OperationStatus_STATUS_INPROGRESS {
+				continue
+			}
+			if logs != nil {
+				if err := relinkLogrefs(logs, op, exported.Logs); err != nil {
+					return count, fmt.Errorf("import logs of operation %v: %w", op.Id, err)
+				}
+			}
+			batch = append(batch, op)
+		}
+
+		oldIds := make([]int64, len(batch))
+		for i, op := range batch {
+			oldIds[i] = op.Id
+		}
+		if err := log.Insert(batch); err != nil {
+			return count, err
+		}
+		for i, op := range batch {
+			idMap[oldIds[i]] = op.Id
+			if op.GetOperationIndexSnapshot().GetForgotByOp() != 0 {
+				forgotByOps = append(forgotByOps, op.Id)
+			}
+		}
+		count += len(batch)
+	}
+
+	// the forget operation is typically created after the snapshot it forgets, so the references are rewritten once all ids are known.
+	for _, id := range forgotByOps {
+		op, err := log.Get(id)
+		if err != nil {
+			return count, fmt.Errorf("relink forget operations: %w", err)
+		}
+		op.GetOperationIndexSnapshot().ForgotByOp = idMap[op.GetOperationIndexSnapshot().ForgotByOp] // 0 if the forget operation wasn't exported.
+		if err := log.Update(op); err != nil {
+			return count, fmt.Errorf("relink forget operations: %w", err)
+		}
+	}
+	return count, nil
+}
+
+// ImportFrom imports the newline delimited protojson operations read from r, see Import.
+func ImportFrom(log OpLog, r io.Reader, logs *rotatinglog.RotatingLog) (int, error) {
+	br := bufio.NewReader(r)
+	line := 0
+	return Import(log, func() (*v1.ExportedOperation, error) {
+		for {
+			bytes, err := br.ReadBytes('\n')
+			if len(bytes) == 0 && err != nil {
+				return nil, err
+			}
+			line++
+			if len(bytes) == 0 || (len(bytes) == 1 && bytes[0] == '\n') {
+				continue
+			}
+			op := &v1.ExportedOperation{}
+			if err := protojson.Unmarshal(bytes, op); err != nil {
+				return nil, fmt.Errorf("line %d: %w", line, err)
+			}
+			return op, nil
+		}
+	}, logs)
+}
+
+// logrefs returns pointers to the logref fields of the operation.
+func logrefs(op *v1.Operation) []*string {
+	refs := []*string{&op.Logref}
+	if hook := op.GetOperationRunHook(); hook != nil {
+		refs = append(refs, &hook.OutputLogref)
+	}
+	return refs
+}
+
+func readLogrefs(logs *rotatinglog.RotatingLog, op *v1.Operation) (map[string][]byte, error) {
+	var data map[string][]byte
+	for _, ref := range logrefs(op) {
+		if *ref == "" {
+			continue
+		}
+		bytes, err := logs.Read(*ref)
+		if errors.Is(err, rotatinglog.ErrFileNotFound) || errors.Is(err, rotatinglog.ErrNotFound) {
+			continue
+		} else if err != nil {
+			return nil, fmt.Errorf("logref %v: %w", *ref, err)
+		}
+		if data == nil {
+			data = make(map[string][]byte)
+		}
+		data[*ref] = bytes
+	}
+	return data, nil
+}
+
+func relinkLogrefs(logs *rotatinglog.RotatingLog, op *v1.Operation, data map[string][]byte) error {
+	for _, ref := range logrefs(op) {
+		bytes, ok := data[*ref]
+		if *ref == "" || !ok {
+			continue
+		}
+		newRef, err := logs.Write(bytes)
+		if err != nil {
+			return fmt.Errorf("logref %v: %w", *ref, err)
+		}
+		*ref = newRef
+	}
+	return nil
+}
@@ -0,0 +1,120 @@
+package oplog
+
+import (
+	"bytes"
+	"slices"
+	"strings"
+	"testing"
+
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+	"github.com/garethgeorge/backrest/internal/rotatinglog"
+)
+
+func TestExportImport(t *testing.T) {
+	t.Parallel()
+	src, err := NewBoltStore(t.TempDir() + "/test.boltdb")
+	if err != nil {
+		t.Fatalf("error creating oplog: %s", err)
+	}
+	t.Cleanup(func() { src.Close() })
+	addQueryTestOps(t, src)
+
+	var buf bytes.Buffer
+	count, err := ExportTo(&buf, src, Query{RepoId: "repo1"}, nil)
+	if err != nil {
+		t.Fatalf("error exporting operations: %s", err)
+	}
+	if count != 3 || strings.Count(buf.String(), "\n") != 3 {
+		t.Fatalf("want 3 exported lines, got %d operations: %q", count, buf.String())
+	}
+
+	dst, err := NewBoltStore(t.TempDir() + "/test.boltdb")
+	if err != nil {
+		t.Fatalf("error creating oplog: %s", err)
+	}
+	t.Cleanup(func() { dst.Close() })
+	if err := dst.Add(&v1.Operation{UnixTimeStartMs: 4500, PlanId: "plan1", RepoId: "repo1", DisplayMessage: "existing", Status: v1.OperationStatus_STATUS_SUCCESS, Op: &v1.Operation_OperationBackup{}}); err != nil {
+		t.Fatalf("error adding operation: %s", err)
+	}
+
+	count, err = ImportFrom(dst, &buf, nil)
+	if err != nil {
+		t.Fatalf("error importing operations: %s", err)
+	}
+	if count != 3 {
+		t.Errorf("want 3 imported operations, got %d", count)
+	}
+
+	// imported ids are derived from the start time so they sort before the operation added just now.
+	got, _ := queryMessages(t, dst, Query{RepoId: "repo1"})
+	if want := []string{"op1", "op4 failed", "op5", "existing"}; !slices.Equal(got, want) {
+		t.Errorf("want operations: %v, got unexpected operations: %v", want, got)
+	}
+	got, _ = queryMessages(t, dst, Query{Statuses: []v1.OperationStatus{v1.OperationStatus_STATUS_ERROR}})
+	if want := []string{"op4 failed"}; !slices.Equal(got, want) {
+		t.Errorf("want operations: %v, got unexpected operations: %v", want, got)
+	}
+}
+
+func TestImportRelinks(t *testing.T) {
+	t.Parallel()
+	srcLogs := rotatinglog.NewRotatingLog(t.TempDir(), 10)
+	src, err := NewBoltStore(t.TempDir() + "/test.boltdb")
+	if err != nil {
+		t.Fatalf("error creating oplog: %s", err)
+	}
+	t.Cleanup(func() { src.Close() })
+
+	ref, err := srcLogs.Write([]byte("forget output"))
+	if err != nil {
+		t.Fatalf("error writing log: %s", err)
+	}
+	snapshotOp := &v1.Operation{UnixTimeStartMs: 1000, PlanId: "plan1", RepoId: "repo1", Status: v1.OperationStatus_STATUS_SUCCESS, Op: &v1.Operation_OperationIndexSnapshot{OperationIndexSnapshot: &v1.OperationIndexSnapshot{Forgot: true}}}
+	forgetOp := &v1.Operation{UnixTimeStartMs: 2000, PlanId: "plan1", RepoId: "repo1", Status: v1.OperationStatus_STATUS_SUCCESS, Logref: ref, Op: &v1.Operation_OperationForget{}}
+	inProgressOp := &v1.Operation{UnixTimeStartMs: 3000, PlanId: "plan1", RepoId: "repo1", Status: v1.OperationStatus_STATUS_INPROGRESS, Op: &v1.Operation_OperationBackup{}}
+	if err := src.BulkAdd([]*v1.Operation{snapshotOp, forgetOp, inProgressOp}); err != nil {
+		t.Fatalf("error adding operations: %s", err)
+	}
+	snapshotOp.GetOperationIndexSnapshot().ForgotByOp = forgetOp.Id
+	if err := src.Update(snapshotOp); err != nil {
+		t.Fatalf("error updating operation: %s", err)
+	}
+
+	var buf bytes.Buffer
+	if _, err := ExportTo(&buf, src, Query{}, srcLogs); err != nil {
+		t.Fatalf("error exporting operations: %s", err)
+	}
+
+	dstLogs := rotatinglog.NewRotatingLog(t.TempDir(), 10)
+	dst, err := NewBoltStore(t.TempDir() + "/test.boltdb")
+	if err != nil {
+		t.Fatalf("error creating oplog: %s", err)
+	}
+	t.Cleanup(func() { dst.Close() })
+	if count, err := ImportFrom(dst, &buf, dstLogs); err != nil {
+		t.Fatalf("error importing operations: %s", err)
+	} else if count != 2 {
+		t.Errorf("want 2 imported operations, in progress operations are skipped, got %d", count)
+	}
+
+	var ops []*v1.Operation
+	if _, err := dst.Query(Query{}, func(op *v1.Operation) error {
+		ops = append(ops, op)
+		return nil
+	}); err != nil {
+		t.Fatalf("error querying operations: %s", err)
+	}
+	if len(ops) != 2 {
+		t.Fatalf("want 2 operations, got %v", ops)
+	}
+	if got := ops[0].GetOperationIndexSnapshot().GetForgotByOp(); got != ops[1].Id {
+		t.Errorf("want forgot_by_op re-linked to %d, got %d", ops[1].Id, got)
+	}
+	data, err := dstLogs.Read(ops[1].Logref)
+	if err != nil {
+		t.Fatalf("error reading re-linked log: %s", err)
+	}
+	if string(data) != "forget output" {
+		t.Errorf("want re-linked log %q, got %q", "forget output", data)
+	}
+}
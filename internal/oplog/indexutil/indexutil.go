@@ -26,6 +26,44 @@ func IndexSearchByteValue(b *bolt.Bucket, value []byte) IndexIterator {
 	return newSearchIterator(b, serializationutil.BytesToKey(value))
 }
 
+// IndexSearchByteRange searches the index for values in the range [from, to) and returns an iterator over the associated
+// recordIds in ascending order. Values must be fixed length for the range to be meaningful e.g. big endian integers.
+func IndexSearchByteRange(b *bolt.Bucket, from []byte, to []byte) IndexIterator {
+	fromKey := serializationutil.BytesToKey(from)
+	toKey := serializationutil.BytesToKey(to)
+
+	var ids []int64
+	c := b.Cursor()
+	for k, _ := c.Seek(fromKey); k != nil && bytes.Compare(k, toKey) < 0; k, _ = c.Next() {
+		if len(k) < 8 {
+			continue
+		}
+		id, err := serializationutil.Btoi(k[len(k)-8:])
+		if err != nil {
+			continue
+		}
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		return ids[i] < ids[j]
+	})
+	return NewSliceIterator(ids)
+}
+
+// IndexCountValues returns the number of recordIds associated with each value in the index, only the keys are read.
+func IndexCountValues(b *bolt.Bucket) map[string]int {
+	counts := make(map[string]int)
+	c := b.Cursor()
+	for k, _ := c.First(); k != nil; k, _ = c.Next() {
+		value, n, err := serializationutil.Btos(k)
+		if err != nil || int64(len(k)) != n+8 {
+			continue // should never happen, indicates database corruption.
+		}
+		counts[value]++
+	}
+	return counts
+}
+
 type IndexIterator interface {
 	Next() (int64, bool)
 }
@@ -59,6 +97,69 @@ func (i *IndexSearchIterator) Next() (int64, bool) {
 	return id, true
 }
 
+// SliceIterator iterates over a sorted slice of recordIds.
+type SliceIterator struct {
+	ids []int64
+}
+
+func NewSliceIterator(ids []int64) *SliceIterator {
+	return &SliceIterator{
+		ids: ids,
+	}
+}
+
+func (s *SliceIterator) Next() (int64, bool) {
+	if len(s.ids) == 0 {
+		return 0, false
+	}
+	id := s.ids[0]
+	s.ids = s.ids[1:]
+	return id, true
+}
+
+// UnionIterator yields each recordId returned by any of its iterators once, in ascending order.
+type UnionIterator struct {
+	iters   []IndexIterator
+	heads   []int64
+	valid   []bool
+	started bool
+}
+
+func NewUnionIterator(iters ...IndexIterator) *UnionIterator {
+	return &UnionIterator{
+		iters: iters,
+		heads: make([]int64, len(iters)),
+		valid: make([]bool, len(iters)),
+	}
+}
+
+func (u *UnionIterator) Next() (int64, bool) {
+	if !u.started {
+		u.started = true
+		for idx, iter := range u.iters {
+			u.heads[idx], u.valid[idx] = iter.Next()
+		}
+	}
+
+	minIdx := -1
+	for idx := range u.iters {
+		if u.valid[idx] && (minIdx == -1 || u.heads[idx] < u.heads[minIdx]) {
+			minIdx = idx
+		}
+	}
+	if minIdx == -1 {
+		return 0, false
+	}
+
+	id := u.heads[minIdx]
+	for idx := range u.iters {
+		if u.valid[idx] && u.heads[idx] == id {
+			u.heads[idx], u.valid[idx] = u.iters[idx].Next()
+		}
+	}
+	return id, true
+}
+
 type JoinIterator struct {
 	iters []IndexIterator
 }
@@ -5,6 +5,7 @@ import (
 	"reflect"
 	"testing"
 
+	"github.com/garethgeorge/backrest/internal/oplog/serializationutil"
 	"go.etcd.io/bbolt"
 )
 
@@ -98,3 +99,121 @@ func TestIndexJoin(t *testing.T) {
 		t.Fatalf("db.View error: %v", err)
 	}
 }
+
+func TestIndexSearchByteRange(t *testing.T) {
+	db, err := bbolt.Open(t.TempDir()+"/test.boltdb", 0600, nil)
+	if err != nil {
+		t.Fatalf("error opening database: %s", err)
+	}
+
+	if err := db.Update(func(tx *bbolt.Tx) error {
+		b, err := tx.CreateBucket([]byte("test"))
+		if err != nil {
+			return fmt.Errorf("error creating bucket: %s", err)
+		}
+		// values are indexed in the opposite order to their record ids.
+		for id := 0; id < 100; id += 1 {
+			if err := IndexByteValue(b, serializationutil.Itob(int64(100-id)), int64(id)); err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		t.Fatalf("db.Update error: %v", err)
+	}
+
+	if err := db.View(func(tx *bbolt.Tx) error {
+		b := tx.Bucket([]byte("test"))
+		ids := CollectAll()(IndexSearchByteRange(b, serializationutil.Itob(10), serializationutil.Itob(20)))
+
+		wantIds := []int64{}
+		for id := 81; id <= 90; id += 1 {
+			wantIds = append(wantIds, int64(id))
+		}
+		if !reflect.DeepEqual(ids, wantIds) {
+			t.Errorf("want %v, got %v", wantIds, ids)
+		}
+		return nil
+	}); err != nil {
+		t.Fatalf("db.View error: %v", err)
+	}
+}
+
+func TestIndexUnion(t *testing.T) {
+	db, err := bbolt.Open(t.TempDir()+"/test.boltdb", 0600, nil)
+	if err != nil {
+		t.Fatalf("error opening database: %s", err)
+	}
+
+	if err := db.Update(func(tx *bbolt.Tx) error {
+		b, err := tx.CreateBucket([]byte("test"))
+		if err != nil {
+			return fmt.Errorf("error creating bucket: %s", err)
+		}
+		for id := 0; id < 20; id += 2 {
+			if err := IndexByteValue(b, []byte("even"), int64(id)); err != nil {
+				return err
+			}
+		}
+		for id := 0; id < 20; id += 3 {
+			if err := IndexByteValue(b, []byte("triple"), int64(id)); err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		t.Fatalf("db.Update error: %v", err)
+	}
+
+	if err := db.View(func(tx *bbolt.Tx) error {
+		b := tx.Bucket([]byte("test"))
+		ids := CollectAll()(NewUnionIterator(IndexSearchByteValue(b, []byte("even")), IndexSearchByteValue(b, []byte("triple")), IndexSearchByteValue(b, []byte("other"))))
+
+		wantIds := []int64{0, 2, 3, 4, 6, 8, 9, 10, 12, 14, 15, 16, 18}
+		if !reflect.DeepEqual(ids, wantIds) {
+			t.Errorf("want %v, got %v", wantIds, ids)
+		}
+		return nil
+	}); err != nil {
+		t.Fatalf("db.View error: %v", err)
+	}
+}
+
+func TestIndexCountValues(t *testing.T) {
+	db, err := bbolt.Open(t.TempDir()+"/test.boltdb", 0600, nil)
+	if err != nil {
+		t.Fatalf("error opening database: %s", err)
+	}
+
+	if err := db.Update(func(tx *bbolt.Tx) error {
+		b, err := tx.CreateBucket([]byte("test"))
+		if err != nil {
+			return fmt.Errorf("error creating bucket: %s", err)
+		}
+		for id := 0; id < 10; id += 1 {
+			value := "even"
+			if id%2 == 1 {
+				value = "odd"
+			}
+			if id == 0 {
+				value = "zero"
+			}
+			if err := IndexByteValue(b, []byte(value), int64(id)); err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		t.Fatalf("db.Update error: %v", err)
+	}
+
+	if err := db.View(func(tx *bbolt.Tx) error {
+		counts := IndexCountValues(tx.Bucket([]byte("test")))
+		if want := map[string]int{"zero": 1, "even": 4, "odd": 5}; !reflect.DeepEqual(counts, want) {
+			t.Errorf("want %v, got %v", want, counts)
+		}
+		return nil
+	}); err != nil {
+		t.Fatalf("db.View error: %v", err)
+	}
+}
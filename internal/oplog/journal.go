@@ -0,0 +1,110 @@
+package oplog
+
+import (
+	"errors"
+	"fmt"
+
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+	"github.com/garethgeorge/backrest/internal/oplog/serializationutil"
+	bolt "go.etcd.io/bbolt"
+	"google.golang.org/protobuf/proto"
+)
+
+// journalMaxEntries is the number of recent events kept in the journal, subscribers further behind must resync.
+const journalMaxEntries = 1024
+
+var eventSeqKey = []byte("event_seq")
+
+// ErrResyncRequired is returned when the events after a seq are no longer in the journal.
+var ErrResyncRequired = errors.New("events are no longer in the journal, a full resync is required")
+
+// journalHelper assigns the next seq to the event and appends it to the journal, dropping the oldest entry once the journal is full.
+func (o *BoltStore) journalHelper(tx *bolt.Tx, eventType v1.OperationEventType, op *v1.Operation) (*v1.OperationEvent, error) {
+	sysBucket := tx.Bucket(SystemBucket)
+	var seq int64
+	if v := sysBucket.Get(eventSeqKey); v != nil {
+		var err error
+		if seq, err = serializationutil.Btoi(v); err != nil {
+			return nil, fmt.Errorf("parse event seq: %w", err)
+		}
+	}
+	seq++
+	if err := sysBucket.Put(eventSeqKey, serializationutil.Itob(seq)); err != nil {
+		return nil, fmt.Errorf("put event seq: %w", err)
+	}
+
+	event := newEventHelper(eventType, op, seq)
+	bytes, err := proto.Marshal(event)
+	if err != nil {
+		return nil, fmt.Errorf("error marshalling event: %w", err)
+	}
+
+	b := tx.Bucket(JournalBucket)
+	if err := b.Put(serializationutil.Itob(seq), bytes); err != nil {
+		return nil, fmt.Errorf("error putting event into journal: %w", err)
+	}
+	if seq > journalMaxEntries {
+		if err := b.Delete(serializationutil.Itob(seq - journalMaxEntries)); err != nil {
+			return nil, fmt.Errorf("error trimming journal: %w", err)
+		}
+	}
+	return event, nil
+}
+
+// EventsSince returns the events after seq in order and the current seq. If the journal no longer holds all of them, or seq is
+// from the future e.g. the database was replaced, ErrResyncRequired is returned along with the current seq.
+func (o *BoltStore) EventsSince(seq int64) ([]*v1.OperationEvent, int64, error) {
+	var events []*v1.OperationEvent
+	var currentSeq int64
+	err := o.db.View(func(tx *bolt.Tx) error {
+		if v := tx.Bucket(SystemBucket).Get(eventSeqKey); v != nil {
+			var err error
+			if currentSeq, err = serializationutil.Btoi(v); err != nil {
+				return fmt.Errorf("parse event seq: %w", err)
+			}
+		}
+		if seq == currentSeq {
+			return nil
+		} else if seq > currentSeq {
+			return ErrResyncRequired
+		}
+
+		c := tx.Bucket(JournalBucket).Cursor()
+		k, v := c.Seek(serializationutil.Itob(seq + 1))
+		if k == nil {
+			return ErrResyncRequired
+		}
+		if first, err := serializationutil.Btoi(k); err != nil {
+			return fmt.Errorf("parse journal key: %w", err)
+		} else if first != seq+1 {
+			return ErrResyncRequired
+		}
+
+		for ; k != nil; k, v = c.Next() {
+			event := &v1.OperationEvent{}
+			if err := proto.Unmarshal(v, event); err != nil {
+				return fmt.Errorf("error unmarshalling event: %w", err)
+			}
+			events = append(events, event)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, currentSeq, err
+	}
+	return events, currentSeq, nil
+}
+
+func (o *BoltStore) CurrentSeq() (int64, error) {
+	var seq int64
+	err := o.db.View(func(tx *bolt.Tx) error {
+		if v := tx.Bucket(SystemBucket).Get(eventSeqKey); v != nil {
+			var err error
+			if seq, err = serializationutil.Btoi(v); err != nil {
+				return fmt.Errorf("parse event seq: %w", err)
+			}
+		}
+		return nil
+	})
+	return seq, err
+}
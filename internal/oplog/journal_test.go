@@ -0,0 +1,115 @@
+package oplog
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+)
+
+func TestEventsSince(t *testing.T) {
+	t.Parallel()
+	path := t.TempDir() + "/test.boltdb"
+	log, err := NewBoltStore(path)
+	if err != nil {
+		t.Fatalf("error creating oplog: %s", err)
+	}
+
+	sub := log.Subscribe(10, OverflowDisconnect)
+
+	op := &v1.Operation{UnixTimeStartMs: 1000, PlanId: "plan1", RepoId: "repo1", Status: v1.OperationStatus_STATUS_INPROGRESS, Op: &v1.Operation_OperationBackup{}}
+	if err := log.Add(op); err != nil {
+		t.Fatalf("error adding operation: %s", err)
+	}
+	op.Status = v1.OperationStatus_STATUS_SUCCESS
+	if err := log.Update(op); err != nil {
+		t.Fatalf("error updating operation: %s", err)
+	}
+	if err := log.Delete(op.Id); err != nil {
+		t.Fatalf("error deleting operation: %s", err)
+	}
+
+	var live []int64
+	for i := 0; i < 3; i++ {
+		event, err := sub.Next(context.Background())
+		if err != nil {
+			t.Fatalf("error receiving event: %s", err)
+		}
+		live = append(live, event.Seq)
+	}
+	sub.Close()
+	if want := []int64{1, 2, 3}; !slices.Equal(live, want) {
+		t.Errorf("want live seqs %v, got %v", want, live)
+	}
+
+	// the seq and journal are persisted.
+	if err := log.Close(); err != nil {
+		t.Fatalf("error closing oplog: %s", err)
+	}
+	log, err = NewBoltStore(path)
+	if err != nil {
+		t.Fatalf("error reopening oplog: %s", err)
+	}
+	t.Cleanup(func() { log.Close() })
+
+	events, seq, err := log.EventsSince(1)
+	if err != nil {
+		t.Fatalf("error getting events: %s", err)
+	}
+	if seq != 3 {
+		t.Errorf("want current seq 3, got %d", seq)
+	}
+	if seq, err := log.CurrentSeq(); err != nil || seq != 3 {
+		t.Errorf("want current seq 3, got %d, err: %v", seq, err)
+	}
+	var types []v1.OperationEventType
+	for _, event := range events {
+		types = append(types, event.Type)
+	}
+	if want := []v1.OperationEventType{v1.OperationEventType_EVENT_UPDATED, v1.OperationEventType_EVENT_DELETED}; !slices.Equal(types, want) {
+		t.Errorf("want event types %v, got %v", want, types)
+	}
+	if events[0].Operation.Status != v1.OperationStatus_STATUS_SUCCESS {
+		t.Errorf("want updated operation in the journal, got %v", events[0].Operation)
+	}
+
+	if events, _, err := log.EventsSince(3); err != nil || len(events) != 0 {
+		t.Errorf("want no events when up to date, got %v, err: %v", events, err)
+	}
+	if _, _, err := log.EventsSince(4); !errors.Is(err, ErrResyncRequired) {
+		t.Errorf("want ErrResyncRequired for a seq from the future, got %v", err)
+	}
+}
+
+func TestEventsSinceTrimmed(t *testing.T) {
+	t.Parallel()
+	log, err := NewBoltStore(t.TempDir() + "/test.boltdb")
+	if err != nil {
+		t.Fatalf("error creating oplog: %s", err)
+	}
+	t.Cleanup(func() { log.Close() })
+
+	var ops []*v1.Operation
+	for i := 0; i < journalMaxEntries+10; i++ {
+		ops = append(ops, &v1.Operation{UnixTimeStartMs: int64(i + 1), PlanId: "plan1", RepoId: "repo1", Status: v1.OperationStatus_STATUS_SUCCESS, Op: &v1.Operation_OperationBackup{}})
+	}
+	if err := log.BulkAdd(ops); err != nil {
+		t.Fatalf("error adding operations: %s", err)
+	}
+
+	if _, seq, err := log.EventsSince(5); !errors.Is(err, ErrResyncRequired) {
+		t.Errorf("want ErrResyncRequired for a trimmed seq, got %v", err)
+	} else if seq != int64(len(ops)) {
+		t.Errorf("want current seq %d, got %d", len(ops), seq)
+	}
+
+	events, _, err := log.EventsSince(10)
+	if err != nil {
+		t.Fatalf("error getting events: %s", err)
+	}
+	if len(events) != journalMaxEntries || events[0].Seq != 11 {
+		t.Errorf("want %d events starting at seq 11, got %d", journalMaxEntries, len(events))
+	}
+}
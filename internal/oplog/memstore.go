@@ -0,0 +1,245 @@
+package oplog
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+	"github.com/garethgeorge/backrest/internal/protoutil"
+	"google.golang.org/protobuf/proto"
+)
+
+// MemStore is an OpLog kept in memory, intended for testing. Queries scan every operation.
+type MemStore struct {
+	baseStore
+
+	mu       sync.RWMutex
+	ops      map[int64]*v1.Operation
+	idSeq    uint64
+	eventSeq int64
+	journal  []*v1.OperationEvent // the most recent events in seq order, at most journalMaxEntries.
+}
+
+var _ OpLog = &MemStore{}
+
+func NewMemStore() *MemStore {
+	return &MemStore{
+		ops: make(map[int64]*v1.Operation),
+	}
+}
+
+func (o *MemStore) Close() error {
+	return nil
+}
+
+func (o *MemStore) Add(op *v1.Operation) error {
+	if op.Id != 0 {
+		return errors.New("operation already has an ID, OpLog.Add is expected to set the ID")
+	}
+	return o.BulkAdd([]*v1.Operation{op})
+}
+
+func (o *MemStore) BulkAdd(ops []*v1.Operation) error {
+	for _, op := range ops {
+		if op.Id != 0 {
+			return errors.New("operation already has an ID, OpLog.BulkAdd is expected to set the ID")
+		}
+	}
+	return o.addHelper(ops, func(op *v1.Operation) int64 { return time.Now().UnixMilli() })
+}
+
+func (o *MemStore) Insert(ops []*v1.Operation) error {
+	return o.addHelper(ops, func(op *v1.Operation) int64 {
+		if op.UnixTimeStartMs <= 0 {
+			return time.Now().UnixMilli()
+		}
+		return op.UnixTimeStartMs
+	})
+}
+
+// addHelper assigns each operation an unused id created at idTime(op) and adds the operations.
+func (o *MemStore) addHelper(ops []*v1.Operation, idTime func(op *v1.Operation) int64) error {
+	o.writeMu.Lock()
+	defer o.writeMu.Unlock()
+	o.mu.Lock()
+	for _, op := range ops {
+		for {
+			o.idSeq++
+			op.Id = operationId(idTime(op), o.idSeq)
+			if _, ok := o.ops[op.Id]; !ok {
+				break
+			}
+		}
+		if err := protoutil.ValidateOperation(op); err != nil {
+			o.mu.Unlock()
+			return fmt.Errorf("validating operation: %w", err)
+		}
+	}
+	events := make([]*v1.OperationEvent, 0, len(ops))
+	for _, op := range ops {
+		o.ops[op.Id] = proto.Clone(op).(*v1.Operation)
+		events = append(events, o.journalHelper(v1.OperationEventType_EVENT_CREATED, op))
+	}
+	o.mu.Unlock()
+
+	o.notifyHelper(events...)
+	return nil
+}
+
+func (o *MemStore) Update(op *v1.Operation) error {
+	if op.Id == 0 {
+		return errors.New("operation does not have an ID, OpLog.Update expects operation with an ID")
+	}
+	if err := protoutil.ValidateOperation(op); err != nil {
+		return fmt.Errorf("validating operation: %w", err)
+	}
+
+	o.writeMu.Lock()
+	defer o.writeMu.Unlock()
+	o.mu.Lock()
+	if _, ok := o.ops[op.Id]; !ok {
+		o.mu.Unlock()
+		return fmt.Errorf("getting existing value prior to update: opid %v: %w", op.Id, ErrNotExist)
+	}
+	o.ops[op.Id] = proto.Clone(op).(*v1.Operation)
+	event := o.journalHelper(v1.OperationEventType_EVENT_UPDATED, op)
+	o.mu.Unlock()
+
+	o.clearProgressHelper(op.Id)
+	o.notifyHelper(event)
+	return nil
+}
+
+func (o *MemStore) UpdateProgress(op *v1.Operation) error {
+	return o.updateProgressHelper(op, o.Update)
+}
+
+func (o *MemStore) Delete(ids ...int64) error {
+	o.writeMu.Lock()
+	defer o.writeMu.Unlock()
+	o.mu.Lock()
+	for _, id := range ids {
+		if _, ok := o.ops[id]; !ok {
+			o.mu.Unlock()
+			return fmt.Errorf("deleting operation %v: opid %v: %w", id, id, ErrNotExist)
+		}
+	}
+	events := make([]*v1.OperationEvent, 0, len(ids))
+	for _, id := range ids {
+		events = append(events, o.journalHelper(v1.OperationEventType_EVENT_DELETED, o.ops[id]))
+		delete(o.ops, id)
+	}
+	o.mu.Unlock()
+
+	o.clearProgressHelper(ids...)
+	o.notifyHelper(events...)
+	return nil
+}
+
+// journalHelper assigns the next seq to the event and appends it to the journal, o.mu must be held.
+func (o *MemStore) journalHelper(eventType v1.OperationEventType, op *v1.Operation) *v1.OperationEvent {
+	o.eventSeq++
+	event := newEventHelper(eventType, op, o.eventSeq)
+	o.journal = append(o.journal, event)
+	if len(o.journal) > journalMaxEntries {
+		o.journal[0] = nil
+		o.journal = o.journal[1:]
+	}
+	return event
+}
+
+func (o *MemStore) Get(id int64) (*v1.Operation, error) {
+	o.mu.RLock()
+	op, ok := o.ops[id]
+	o.mu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("opid %v: %w", id, ErrNotExist)
+	}
+	return o.withProgressHelper(proto.Clone(op).(*v1.Operation)), nil
+}
+
+// Query calls do for each operation matching the query ordered by id, see BoltStore.Query. The matching operations are copied
+// before do is called so do may use the store.
+func (o *MemStore) Query(q Query, do func(op *v1.Operation) error) (int64, error) {
+	var ops []*v1.Operation
+	o.mu.RLock()
+	for _, op := range o.ops {
+		if (q.Reverse && q.Cursor != 0 && op.Id >= q.Cursor) || (!q.Reverse && op.Id <= q.Cursor) || !memIndexedFieldsMatch(&q, op) {
+			continue
+		}
+		ops = append(ops, proto.Clone(op).(*v1.Operation))
+	}
+	o.mu.RUnlock()
+
+	slices.SortFunc(ops, func(a, b *v1.Operation) int {
+		if q.Reverse {
+			a, b = b, a
+		}
+		if a.Id < b.Id {
+			return -1
+		} else if a.Id > b.Id {
+			return 1
+		}
+		return 0
+	})
+	return o.visitHelper(&q, func() (*v1.Operation, error) {
+		if len(ops) == 0 {
+			return nil, nil
+		}
+		op := ops[0]
+		ops = ops[1:]
+		return op, nil
+	}, do)
+}
+
+// memIndexedFieldsMatch returns true if the operation matches the filters a BoltStore resolves with its indices.
+func memIndexedFieldsMatch(q *Query, op *v1.Operation) bool {
+	if (q.RepoId != "" && op.RepoId != q.RepoId) || (q.PlanId != "" && op.PlanId != q.PlanId) || (q.SnapshotId != "" && op.SnapshotId != q.SnapshotId) {
+		return false
+	}
+	if (q.StartTimeMs != 0 || q.EndTimeMs != 0) && (op.UnixTimeStartMs < q.StartTimeMs || (q.EndTimeMs != 0 && op.UnixTimeStartMs >= q.EndTimeMs)) {
+		return false
+	}
+	if len(q.Statuses) > 0 && !slices.Contains(q.Statuses, op.Status) {
+		return false
+	}
+	if len(q.Types) > 0 && !slices.Contains(q.Types, protoutil.OperationType(op)) {
+		return false
+	}
+	return true
+}
+
+func (o *MemStore) CountByPlan() (map[string]int, error) {
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+	counts := make(map[string]int)
+	for _, op := range o.ops {
+		counts[op.PlanId]++
+	}
+	return counts, nil
+}
+
+func (o *MemStore) Scan(onIncomplete func(op *v1.Operation)) error {
+	return scanHelper(o, onIncomplete)
+}
+
+func (o *MemStore) EventsSince(seq int64) ([]*v1.OperationEvent, int64, error) {
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+	if seq == o.eventSeq {
+		return nil, o.eventSeq, nil
+	} else if seq > o.eventSeq || len(o.journal) == 0 || o.journal[0].Seq > seq+1 {
+		return nil, o.eventSeq, ErrResyncRequired
+	}
+	events := slices.Clone(o.journal[seq+1-o.journal[0].Seq:])
+	return events, o.eventSeq, nil
+}
+
+func (o *MemStore) CurrentSeq() (int64, error) {
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+	return o.eventSeq, nil
+}
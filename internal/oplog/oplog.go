@@ -3,9 +3,9 @@ package oplog
 import (
 	"errors"
 	"fmt"
+	"io"
 	"os"
 	"path"
-	"sync"
 	"time"
 
 	v1 "github.com/garethgeorge/backrest/gen/go/v1"
@@ -35,18 +35,29 @@ var (
 	RepoIndexBucket     = []byte("oplog.repo_idx")     // repo_index tracks IDs of operations affecting a given repo
 	PlanIndexBucket     = []byte("oplog.plan_idx")     // plan_index tracks IDs of operations affecting a given plan
 	SnapshotIndexBucket = []byte("oplog.snapshot_idx") // snapshot_index tracks IDs of operations affecting a given snapshot
+	TimeIndexBucket     = []byte("oplog.time_idx")     // time_index tracks IDs of operations by their start time
+	TypeIndexBucket     = []byte("oplog.type_idx")     // type_index tracks IDs of operations by their v1.OperationType
+	StatusIndexBucket   = []byte("oplog.status_idx")   // status_index tracks IDs of operations by their v1.OperationStatus
+	JournalBucket       = []byte("oplog.journal")      // journal stores the most recent events by seq so subscribers can resume
 )
 
-// OpLog represents a log of operations performed.
-// Operations are indexed by repo and plan.
-type OpLog struct {
-	db *bolt.DB
+// indexBuckets are the buckets rebuilt from the log when the index version changes.
+var indexBuckets = [][]byte{RepoIndexBucket, PlanIndexBucket, SnapshotIndexBucket, TimeIndexBucket, TypeIndexBucket, StatusIndexBucket}
+
+// indexVersion is incremented whenever an index is added or changed, the indices of older databases are rebuilt on startup.
+const indexVersion = 2
+
+var indexVersionKey = []byte("index_version")
 
-	subscribersMu sync.RWMutex
-	subscribers   []*func(*v1.Operation, *v1.Operation)
+// BoltStore is an OpLog stored in a bbolt database. Operations are indexed by repo, plan, snapshot, time, type and status.
+type BoltStore struct {
+	baseStore
+	db *bolt.DB
 }
 
-func NewOpLog(databasePath string) (*OpLog, error) {
+var _ OpLog = &BoltStore{}
+
+func NewBoltStore(databasePath string) (*BoltStore, error) {
 	if err := os.MkdirAll(path.Dir(databasePath), 0700); err != nil {
 		return nil, fmt.Errorf("error creating database directory: %s", err)
 	}
@@ -56,30 +67,112 @@ func NewOpLog(databasePath string) (*OpLog, error) {
 		return nil, fmt.Errorf("error opening database: %s", err)
 	}
 
-	o := &OpLog{
+	o := &BoltStore{
 		db: db,
 	}
 
+	migrated := false
 	if err := db.Update(func(tx *bolt.Tx) error {
 		// Create the buckets if they don't exist
-		for _, bucket := range [][]byte{
-			SystemBucket, OpLogBucket, RepoIndexBucket, PlanIndexBucket, SnapshotIndexBucket,
-		} {
+		for _, bucket := range append([][]byte{SystemBucket, OpLogBucket, JournalBucket}, indexBuckets...) {
 			if _, err := tx.CreateBucketIfNotExists(bucket); err != nil {
 				return fmt.Errorf("creating bucket %s: %s", string(bucket), err)
 			}
 		}
 
-		return nil
+		var err error
+		migrated, err = o.migrateIndicesHelper(tx)
+		return err
 	}); err != nil {
 		return nil, err
 	}
 
+	// the structure of the whole database is only checked after a migration, it reads every page.
+	if err := o.checkIntegrity(migrated); err != nil {
+		return nil, err
+	}
+
 	return o, nil
 }
 
-// Scan checks the log for incomplete operations. Should only be called at startup.
-func (o *OpLog) Scan(onIncomplete func(op *v1.Operation)) error {
+// CheckIntegrity verifies the structure of the database and that the indices match the log, rebuilding the indices if
+// either is damaged. It reads the whole database.
+func (o *BoltStore) CheckIntegrity() error {
+	return o.checkIntegrity(true)
+}
+
+// checkIntegrity verifies that the indices match the log and, if full is set, the structure of the database. If either is
+// damaged the indices, which are derived data, are rebuilt from the log so that queries don't return missing or stale operations.
+func (o *BoltStore) checkIntegrity(full bool) error {
+	var problems []error
+	if err := o.db.View(func(tx *bolt.Tx) error {
+		if full {
+			for err := range tx.Check() {
+				problems = append(problems, err)
+			}
+		}
+		// every operation has exactly one time index entry, a mismatch means the indices drifted from the log. Counting the entries
+		// walks every page of both buckets, which is most of the database, but doesn't verify the pages as tx.Check does.
+		if ops, indexed := tx.Bucket(OpLogBucket).Stats().KeyN, tx.Bucket(TimeIndexBucket).Stats().KeyN; ops != indexed {
+			problems = append(problems, fmt.Errorf("time index has %d entries for %d operations", indexed, ops))
+		}
+		return nil
+	}); err != nil {
+		return fmt.Errorf("checking database integrity: %w", err)
+	}
+	if len(problems) == 0 {
+		return nil
+	}
+
+	zap.L().Error("oplog integrity check failed, rebuilding indices", zap.Error(errors.Join(problems...)))
+	if err := o.db.Update(o.rebuildIndicesHelper); err != nil {
+		return fmt.Errorf("rebuilding indices after failed integrity check: %w", err)
+	}
+	return nil
+}
+
+// migrateIndicesHelper rebuilds the indices of a database created with an older index version, it returns true if they were rebuilt.
+func (o *BoltStore) migrateIndicesHelper(tx *bolt.Tx) (bool, error) {
+	sysBucket := tx.Bucket(SystemBucket)
+	if v := sysBucket.Get(indexVersionKey); v != nil {
+		if version, err := serializationutil.Btoi(v); err == nil && version >= indexVersion {
+			return false, nil
+		}
+	}
+
+	zap.L().Info("rebuilding oplog indices", zap.Int("index_version", indexVersion))
+	return true, o.rebuildIndicesHelper(tx)
+}
+
+// rebuildIndicesHelper recreates every index from the operations in OpLogBucket.
+func (o *BoltStore) rebuildIndicesHelper(tx *bolt.Tx) error {
+	sysBucket := tx.Bucket(SystemBucket)
+	for _, bucket := range indexBuckets {
+		if err := tx.DeleteBucket(bucket); err != nil {
+			return fmt.Errorf("deleting index %s: %w", string(bucket), err)
+		}
+		if _, err := tx.CreateBucket(bucket); err != nil {
+			return fmt.Errorf("creating index %s: %w", string(bucket), err)
+		}
+	}
+
+	c := tx.Bucket(OpLogBucket).Cursor()
+	for k, v := c.First(); k != nil; k, v = c.Next() {
+		op := &v1.Operation{}
+		if err := proto.Unmarshal(v, op); err != nil {
+			zap.L().Error("error unmarshalling operation, there may be corruption in the oplog", zap.Error(err))
+			continue
+		}
+		if err := o.indexOperationHelper(tx, op); err != nil {
+			return fmt.Errorf("indexing operation %v: %w", op.Id, err)
+		}
+	}
+
+	return sysBucket.Put(indexVersionKey, serializationutil.Itob(indexVersion))
+}
+
+// Scan checks the log for incomplete operations, see scanHelper for what is removed. Should only be called at startup.
+func (o *BoltStore) Scan(onIncomplete func(op *v1.Operation)) error {
 	removeIds := make([]int64, 0)
 
 	err := o.db.Update(func(tx *bolt.Tx) error {
@@ -96,8 +189,9 @@ func (o *OpLog) Scan(onIncomplete func(op *v1.Operation)) error {
 				continue
 			}
 
-			if op.Status == v1.OperationStatus_STATUS_PENDING || op.Status == v1.OperationStatus_STATUS_SYSTEM_CANCELLED || op.Status == v1.OperationStatus_STATUS_USER_CANCELLED || op.Status == v1.OperationStatus_STATUS_UNKNOWN {
-				// remove pending or user cancelled operations.
+			cancelled := op.Status == v1.OperationStatus_STATUS_SYSTEM_CANCELLED || op.Status == v1.OperationStatus_STATUS_USER_CANCELLED
+			if op.Status == v1.OperationStatus_STATUS_PENDING || op.Status == v1.OperationStatus_STATUS_UNKNOWN || (cancelled && op.DisplayMessage == "") {
+				// remove pending or cancelled operations, cancellations with a message e.g. a backup skipped by a hook are kept.
 				removeIds = append(removeIds, op.Id)
 				continue
 			} else if op.Status == v1.OperationStatus_STATUS_INPROGRESS {
@@ -129,30 +223,50 @@ func (o *OpLog) Scan(onIncomplete func(op *v1.Operation)) error {
 	return nil
 }
 
-func (o *OpLog) Close() error {
+// WriteSnapshot writes a consistent copy of the database to w while the log stays available for reads and writes.
+func (o *BoltStore) WriteSnapshot(w io.Writer) (int64, error) {
+	var n int64
+	err := o.db.View(func(tx *bolt.Tx) error {
+		var err error
+		n, err = tx.WriteTo(w)
+		return err
+	})
+	return n, err
+}
+
+func (o *BoltStore) Close() error {
 	return o.db.Close()
 }
 
 // Add adds a generic operation to the operation log.
-func (o *OpLog) Add(op *v1.Operation) error {
+func (o *BoltStore) Add(op *v1.Operation) error {
 	if op.Id != 0 {
 		return errors.New("operation already has an ID, OpLog.Add is expected to set the ID")
 	}
 
+	o.writeMu.Lock()
+	defer o.writeMu.Unlock()
+
+	var event *v1.OperationEvent
 	err := o.db.Update(func(tx *bolt.Tx) error {
 		err := o.addOperationHelper(tx, op)
 		if err != nil {
 			return err
 		}
-		return nil
+		event, err = o.journalHelper(tx, v1.OperationEventType_EVENT_CREATED, op)
+		return err
 	})
 	if err == nil {
-		o.notifyHelper(nil, op)
+		o.notifyHelper(event)
 	}
 	return err
 }
 
-func (o *OpLog) BulkAdd(ops []*v1.Operation) error {
+func (o *BoltStore) BulkAdd(ops []*v1.Operation) error {
+	o.writeMu.Lock()
+	defer o.writeMu.Unlock()
+
+	var events []*v1.OperationEvent
 	err := o.db.Update(func(tx *bolt.Tx) error {
 		for _, op := range ops {
 			if op.Id != 0 {
@@ -161,68 +275,114 @@ func (o *OpLog) BulkAdd(ops []*v1.Operation) error {
 			if err := o.addOperationHelper(tx, op); err != nil {
 				return err
 			}
+			event, err := o.journalHelper(tx, v1.OperationEventType_EVENT_CREATED, op)
+			if err != nil {
+				return err
+			}
+			events = append(events, event)
 		}
 		return nil
 	})
 	if err == nil {
+		o.notifyHelper(events...)
+	}
+	return err
+}
+
+func (o *BoltStore) Insert(ops []*v1.Operation) error {
+	o.writeMu.Lock()
+	defer o.writeMu.Unlock()
+
+	var events []*v1.OperationEvent
+	err := o.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(OpLogBucket)
 		for _, op := range ops {
-			o.notifyHelper(nil, op)
+			id, err := o.nextImportedOperationId(b, op.UnixTimeStartMs)
+			if err != nil {
+				return err
+			}
+			prevId := op.Id
+			op.Id = id
+			if err := o.addOperationHelper(tx, op); err != nil {
+				return fmt.Errorf("add operation %v: %w", prevId, err)
+			}
+			event, err := o.journalHelper(tx, v1.OperationEventType_EVENT_CREATED, op)
+			if err != nil {
+				return err
+			}
+			events = append(events, event)
 		}
+		return nil
+	})
+	if err == nil {
+		o.notifyHelper(events...)
 	}
 	return err
 }
 
-func (o *OpLog) Update(op *v1.Operation) error {
+func (o *BoltStore) Update(op *v1.Operation) error {
 	if op.Id == 0 {
 		return errors.New("operation does not have an ID, OpLog.Update expects operation with an ID")
 	}
-	var oldOp *v1.Operation
+	o.writeMu.Lock()
+	defer o.writeMu.Unlock()
+
+	var event *v1.OperationEvent
 	err := o.db.Update(func(tx *bolt.Tx) error {
-		var err error
-		oldOp, err = o.deleteOperationHelper(tx, op.Id)
+		prev, err := o.getOperationHelper(tx.Bucket(OpLogBucket), op.Id)
 		if err != nil {
-			return fmt.Errorf("deleting existing value prior to update: %w", err)
+			return fmt.Errorf("getting existing value prior to update: %w", err)
 		}
-		if err := o.addOperationHelper(tx, op); err != nil {
-			return fmt.Errorf("adding updated value: %w", err)
+		if indexedFieldsEqual(prev, op) {
+			// most updates only change progress or output, the index entries can stay as they are.
+			if err := o.putOperationHelper(tx, op); err != nil {
+				return fmt.Errorf("putting updated value: %w", err)
+			}
+		} else {
+			if _, err := o.deleteOperationHelper(tx, op.Id); err != nil {
+				return fmt.Errorf("deleting existing value prior to update: %w", err)
+			}
+			if err := o.addOperationHelper(tx, op); err != nil {
+				return fmt.Errorf("adding updated value: %w", err)
+			}
 		}
-		return nil
+		event, err = o.journalHelper(tx, v1.OperationEventType_EVENT_UPDATED, op)
+		return err
 	})
 	if err == nil {
-		o.notifyHelper(oldOp, op)
+		o.clearProgressHelper(op.Id)
+		o.notifyHelper(event)
 	}
 	return err
 }
 
-func (o *OpLog) Delete(ids ...int64) error {
-	removedOps := make([]*v1.Operation, 0, len(ids))
+func (o *BoltStore) Delete(ids ...int64) error {
+	o.writeMu.Lock()
+	defer o.writeMu.Unlock()
+
+	events := make([]*v1.OperationEvent, 0, len(ids))
 	err := o.db.Update(func(tx *bolt.Tx) error {
 		for _, id := range ids {
 			removed, err := o.deleteOperationHelper(tx, id)
 			if err != nil {
 				return fmt.Errorf("deleting operation %v: %w", id, err)
 			}
-			removedOps = append(removedOps, removed)
+			event, err := o.journalHelper(tx, v1.OperationEventType_EVENT_DELETED, removed)
+			if err != nil {
+				return err
+			}
+			events = append(events, event)
 		}
 		return nil
 	})
 	if err == nil {
-		for _, op := range removedOps {
-			o.notifyHelper(op, nil)
-		}
+		o.clearProgressHelper(ids...)
+		o.notifyHelper(events...)
 	}
 	return err
 }
 
-func (o *OpLog) notifyHelper(old *v1.Operation, new *v1.Operation) {
-	o.subscribersMu.RLock()
-	defer o.subscribersMu.RUnlock()
-	for _, sub := range o.subscribers {
-		(*sub)(old, new)
-	}
-}
-
-func (o *OpLog) getOperationHelper(b *bolt.Bucket, id int64) (*v1.Operation, error) {
+func (o *BoltStore) getOperationHelper(b *bolt.Bucket, id int64) (*v1.Operation, error) {
 	bytes := b.Get(serializationutil.Itob(id))
 	if bytes == nil {
 		return nil, fmt.Errorf("opid %v: %w", id, ErrNotExist)
@@ -236,24 +396,47 @@ func (o *OpLog) getOperationHelper(b *bolt.Bucket, id int64) (*v1.Operation, err
 	return &op, nil
 }
 
-func (o *OpLog) nextOperationId(b *bolt.Bucket, unixTimeMs int64) (int64, error) {
+func (o *BoltStore) nextOperationId(b *bolt.Bucket, unixTimeMs int64) (int64, error) {
 	seq, err := b.NextSequence()
 	if err != nil {
 		return 0, fmt.Errorf("next sequence: %w", err)
 	}
-	return int64(unixTimeMs<<20) | int64(seq&((1<<20)-1)), nil
+	return operationId(unixTimeMs, seq), nil
 }
 
-func (o *OpLog) addOperationHelper(tx *bolt.Tx, op *v1.Operation) error {
-	b := tx.Bucket(OpLogBucket)
+// nextImportedOperationId returns an unused id for an operation created at unixTimeMs.
+func (o *BoltStore) nextImportedOperationId(b *bolt.Bucket, unixTimeMs int64) (int64, error) {
+	if unixTimeMs <= 0 {
+		unixTimeMs = time.Now().UnixMilli()
+	}
+	for {
+		id, err := o.nextOperationId(b, unixTimeMs)
+		if err != nil {
+			return 0, fmt.Errorf("create next operation ID: %w", err)
+		}
+		if b.Get(serializationutil.Itob(id)) == nil {
+			return id, nil
+		}
+	}
+}
+
+func (o *BoltStore) addOperationHelper(tx *bolt.Tx, op *v1.Operation) error {
 	if op.Id == 0 {
 		var err error
-		op.Id, err = o.nextOperationId(b, time.Now().UnixMilli())
+		op.Id, err = o.nextOperationId(tx.Bucket(OpLogBucket), time.Now().UnixMilli())
 		if err != nil {
 			return fmt.Errorf("create next operation ID: %w", err)
 		}
 	}
 
+	if err := o.putOperationHelper(tx, op); err != nil {
+		return err
+	}
+	return o.indexOperationHelper(tx, op)
+}
+
+// putOperationHelper stores the operation without touching the indices.
+func (o *BoltStore) putOperationHelper(tx *bolt.Tx, op *v1.Operation) error {
 	if err := protoutil.ValidateOperation(op); err != nil {
 		return fmt.Errorf("validating operation: %w", err)
 	}
@@ -263,10 +446,24 @@ func (o *OpLog) addOperationHelper(tx *bolt.Tx, op *v1.Operation) error {
 		return fmt.Errorf("error marshalling operation: %w", err)
 	}
 
-	if err := b.Put(serializationutil.Itob(op.Id), bytes); err != nil {
+	if err := tx.Bucket(OpLogBucket).Put(serializationutil.Itob(op.Id), bytes); err != nil {
 		return fmt.Errorf("error putting operation into bucket: %w", err)
 	}
+	return nil
+}
 
+// indexedFieldsEqual returns true if the operations have the same index entries.
+func indexedFieldsEqual(a, b *v1.Operation) bool {
+	return a.Id == b.Id &&
+		a.RepoId == b.RepoId &&
+		a.PlanId == b.PlanId &&
+		a.SnapshotId == b.SnapshotId &&
+		a.UnixTimeStartMs == b.UnixTimeStartMs &&
+		a.Status == b.Status &&
+		protoutil.OperationType(a) == protoutil.OperationType(b)
+}
+
+func (o *BoltStore) indexOperationHelper(tx *bolt.Tx, op *v1.Operation) error {
 	// Update always universal indices
 	if op.RepoId != "" {
 		if err := indexutil.IndexByteValue(tx.Bucket(RepoIndexBucket), []byte(op.RepoId), op.Id); err != nil {
@@ -283,11 +480,20 @@ func (o *OpLog) addOperationHelper(tx *bolt.Tx, op *v1.Operation) error {
 			return fmt.Errorf("error adding operation to snapshot index: %w", err)
 		}
 	}
+	if err := indexutil.IndexByteValue(tx.Bucket(TimeIndexBucket), serializationutil.Itob(op.UnixTimeStartMs), op.Id); err != nil {
+		return fmt.Errorf("error adding operation to time index: %w", err)
+	}
+	if err := indexutil.IndexByteValue(tx.Bucket(TypeIndexBucket), serializationutil.Itob(int64(protoutil.OperationType(op))), op.Id); err != nil {
+		return fmt.Errorf("error adding operation to type index: %w", err)
+	}
+	if err := indexutil.IndexByteValue(tx.Bucket(StatusIndexBucket), serializationutil.Itob(int64(op.Status)), op.Id); err != nil {
+		return fmt.Errorf("error adding operation to status index: %w", err)
+	}
 
 	return nil
 }
 
-func (o *OpLog) deleteOperationHelper(tx *bolt.Tx, id int64) (*v1.Operation, error) {
+func (o *BoltStore) deleteOperationHelper(tx *bolt.Tx, id int64) (*v1.Operation, error) {
 	b := tx.Bucket(OpLogBucket)
 
 	prevValue, err := o.getOperationHelper(b, id)
@@ -295,32 +501,53 @@ func (o *OpLog) deleteOperationHelper(tx *bolt.Tx, id int64) (*v1.Operation, err
 		return nil, fmt.Errorf("getting operation %v: %w", id, err)
 	}
 
+	if err := o.unindexOperationHelper(tx, prevValue); err != nil {
+		return nil, err
+	}
+
+	if err := b.Delete(serializationutil.Itob(id)); err != nil {
+		return nil, fmt.Errorf("deleting operation %v from bucket: %w", id, err)
+	}
+
+	return prevValue, nil
+}
+
+func (o *BoltStore) unindexOperationHelper(tx *bolt.Tx, prevValue *v1.Operation) error {
+	id := prevValue.Id
 	if prevValue.PlanId != "" {
 		if err := indexutil.IndexRemoveByteValue(tx.Bucket(PlanIndexBucket), []byte(prevValue.PlanId), id); err != nil {
-			return nil, fmt.Errorf("removing operation %v from plan index: %w", id, err)
+			return fmt.Errorf("removing operation %v from plan index: %w", id, err)
 		}
 	}
 
 	if prevValue.RepoId != "" {
 		if err := indexutil.IndexRemoveByteValue(tx.Bucket(RepoIndexBucket), []byte(prevValue.RepoId), id); err != nil {
-			return nil, fmt.Errorf("removing operation %v from repo index: %w", id, err)
+			return fmt.Errorf("removing operation %v from repo index: %w", id, err)
 		}
 	}
 
 	if prevValue.SnapshotId != "" {
 		if err := indexutil.IndexRemoveByteValue(tx.Bucket(SnapshotIndexBucket), []byte(prevValue.SnapshotId), id); err != nil {
-			return nil, fmt.Errorf("removing operation %v from snapshot index: %w", id, err)
+			return fmt.Errorf("removing operation %v from snapshot index: %w", id, err)
 		}
 	}
 
-	if err := b.Delete(serializationutil.Itob(id)); err != nil {
-		return nil, fmt.Errorf("deleting operation %v from bucket: %w", id, err)
+	if err := indexutil.IndexRemoveByteValue(tx.Bucket(TimeIndexBucket), serializationutil.Itob(prevValue.UnixTimeStartMs), id); err != nil {
+		return fmt.Errorf("removing operation %v from time index: %w", id, err)
 	}
 
-	return prevValue, nil
+	if err := indexutil.IndexRemoveByteValue(tx.Bucket(TypeIndexBucket), serializationutil.Itob(int64(protoutil.OperationType(prevValue))), id); err != nil {
+		return fmt.Errorf("removing operation %v from type index: %w", id, err)
+	}
+
+	if err := indexutil.IndexRemoveByteValue(tx.Bucket(StatusIndexBucket), serializationutil.Itob(int64(prevValue.Status)), id); err != nil {
+		return fmt.Errorf("removing operation %v from status index: %w", id, err)
+	}
+
+	return nil
 }
 
-func (o *OpLog) Get(id int64) (*v1.Operation, error) {
+func (o *BoltStore) Get(id int64) (*v1.Operation, error) {
 	var op *v1.Operation
 	if err := o.db.View(func(tx *bolt.Tx) error {
 		var err error
@@ -329,24 +556,45 @@ func (o *OpLog) Get(id int64) (*v1.Operation, error) {
 	}); err != nil {
 		return nil, err
 	}
-	return op, nil
+	return o.withProgressHelper(op), nil
 }
 
-func (o *OpLog) ForEachByRepo(repoId string, collector indexutil.Collector, do func(op *v1.Operation) error) error {
+// CountByPlan returns the number of operations of each plan, operations without a plan are counted under "".
+func (o *BoltStore) CountByPlan() (map[string]int, error) {
+	var counts map[string]int
+	if err := o.db.View(func(tx *bolt.Tx) error {
+		counts = indexutil.IndexCountValues(tx.Bucket(PlanIndexBucket))
+
+		// operations without a plan aren't in the plan index.
+		unplanned := tx.Bucket(OpLogBucket).Stats().KeyN
+		for _, count := range counts {
+			unplanned -= count
+		}
+		if unplanned > 0 {
+			counts[""] = unplanned
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return counts, nil
+}
+
+func (o *BoltStore) ForEachByRepo(repoId string, collector indexutil.Collector, do func(op *v1.Operation) error) error {
 	return o.db.View(func(tx *bolt.Tx) error {
 		ids := collector(indexutil.IndexSearchByteValue(tx.Bucket(RepoIndexBucket), []byte(repoId)))
 		return o.forOpsByIds(tx, ids, do)
 	})
 }
 
-func (o *OpLog) ForEachByPlan(planId string, collector indexutil.Collector, do func(op *v1.Operation) error) error {
+func (o *BoltStore) ForEachByPlan(planId string, collector indexutil.Collector, do func(op *v1.Operation) error) error {
 	return o.db.View(func(tx *bolt.Tx) error {
 		ids := collector(indexutil.IndexSearchByteValue(tx.Bucket(PlanIndexBucket), []byte(planId)))
 		return o.forOpsByIds(tx, ids, do)
 	})
 }
 
-func (o *OpLog) ForEachBySnapshotId(snapshotId string, collector indexutil.Collector, do func(op *v1.Operation) error) error {
+func (o *BoltStore) ForEachBySnapshotId(snapshotId string, collector indexutil.Collector, do func(op *v1.Operation) error) error {
 	if err := restic.ValidateSnapshotId(snapshotId); err != nil {
 		return nil
 	}
@@ -356,7 +604,7 @@ func (o *OpLog) ForEachBySnapshotId(snapshotId string, collector indexutil.Colle
 	})
 }
 
-func (o *OpLog) forOpsByIds(tx *bolt.Tx, ids []int64, do func(*v1.Operation) error) error {
+func (o *BoltStore) forOpsByIds(tx *bolt.Tx, ids []int64, do func(*v1.Operation) error) error {
 	b := tx.Bucket(OpLogBucket)
 	for _, id := range ids {
 		op, err := o.getOperationHelper(b, id)
@@ -373,7 +621,7 @@ func (o *OpLog) forOpsByIds(tx *bolt.Tx, ids []int64, do func(*v1.Operation) err
 	return nil
 }
 
-func (o *OpLog) ForAll(do func(op *v1.Operation) error) error {
+func (o *BoltStore) ForAll(do func(op *v1.Operation) error) error {
 	if err := o.db.View(func(tx *bolt.Tx) error {
 		c := tx.Bucket(OpLogBucket).Cursor()
 		for k, v := c.First(); k != nil; k, v = c.Next() {
@@ -391,21 +639,3 @@ func (o *OpLog) ForAll(do func(op *v1.Operation) error) error {
 	}
 	return nil
 }
-
-func (o *OpLog) Subscribe(callback *func(*v1.Operation, *v1.Operation)) {
-	o.subscribersMu.Lock()
-	defer o.subscribersMu.Unlock()
-	o.subscribers = append(o.subscribers, callback)
-}
-
-func (o *OpLog) Unsubscribe(callback *func(*v1.Operation, *v1.Operation)) {
-	o.subscribersMu.Lock()
-	defer o.subscribersMu.Unlock()
-	subs := o.subscribers
-	for i, c := range subs {
-		if c == callback {
-			subs[i] = subs[len(subs)-1]
-			o.subscribers = subs[:len(o.subscribers)-1]
-		}
-	}
-}
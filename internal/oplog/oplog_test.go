@@ -1,11 +1,13 @@
 package oplog
 
 import (
+	"os"
 	"slices"
 	"testing"
 
 	v1 "github.com/garethgeorge/backrest/gen/go/v1"
 	"github.com/garethgeorge/backrest/internal/oplog/indexutil"
+	bolt "go.etcd.io/bbolt"
 )
 
 const (
@@ -15,7 +17,7 @@ const (
 
 func TestCreate(t *testing.T) {
 	// t.Parallel()
-	log, err := NewOpLog(t.TempDir() + "/test.boltdb")
+	log, err := NewBoltStore(t.TempDir() + "/test.boltdb")
 	t.Cleanup(func() { log.Close() })
 	if err != nil {
 		t.Fatalf("error creating oplog: %s", err)
@@ -26,7 +28,7 @@ func TestCreate(t *testing.T) {
 }
 
 func TestAddOperation(t *testing.T) {
-	log, err := NewOpLog(t.TempDir() + "/test.boltdb")
+	log, err := NewBoltStore(t.TempDir() + "/test.boltdb")
 	if err != nil {
 		t.Fatalf("error creating oplog: %s", err)
 	}
@@ -117,7 +119,7 @@ func TestAddOperation(t *testing.T) {
 
 func TestListOperation(t *testing.T) {
 	// t.Parallel()
-	log, err := NewOpLog(t.TempDir() + "/test.boltdb")
+	log, err := NewBoltStore(t.TempDir() + "/test.boltdb")
 	if err != nil {
 		t.Fatalf("error creating oplog: %s", err)
 	}
@@ -220,7 +222,7 @@ func TestBigIO(t *testing.T) {
 
 	count := 10
 
-	log, err := NewOpLog(t.TempDir() + "/test.boltdb")
+	log, err := NewBoltStore(t.TempDir() + "/test.boltdb")
 	if err != nil {
 		t.Fatalf("error creating oplog: %s", err)
 	}
@@ -243,7 +245,7 @@ func TestBigIO(t *testing.T) {
 
 func TestIndexSnapshot(t *testing.T) {
 	t.Parallel()
-	log, err := NewOpLog(t.TempDir() + "/test.boltdb")
+	log, err := NewBoltStore(t.TempDir() + "/test.boltdb")
 	if err != nil {
 		t.Fatalf("error creating oplog: %s", err)
 	}
@@ -277,7 +279,7 @@ func TestIndexSnapshot(t *testing.T) {
 
 func TestUpdateOperation(t *testing.T) {
 	t.Parallel()
-	log, err := NewOpLog(t.TempDir() + "/test.boltdb")
+	log, err := NewBoltStore(t.TempDir() + "/test.boltdb")
 	if err != nil {
 		t.Fatalf("error creating oplog: %s", err)
 	}
@@ -323,6 +325,80 @@ func TestUpdateOperation(t *testing.T) {
 	countBySnapshotIdHelper(t, log, snapshotId, 0)
 }
 
+func TestRebuildIndicesOnOpen(t *testing.T) {
+	t.Parallel()
+	dbPath := t.TempDir() + "/test.boltdb"
+	log, err := NewBoltStore(dbPath)
+	if err != nil {
+		t.Fatalf("error creating oplog: %s", err)
+	}
+	for _, msg := range []string{"op1", "op2"} {
+		if err := log.Add(&v1.Operation{UnixTimeStartMs: 1000, PlanId: "plan1", RepoId: "repo1", DisplayMessage: msg, Op: &v1.Operation_OperationBackup{}}); err != nil {
+			t.Fatalf("error adding operation: %s", err)
+		}
+	}
+
+	// drop the entries of the repo and time indices, as if they were lost.
+	if err := log.db.Update(func(tx *bolt.Tx) error {
+		for _, bucket := range [][]byte{RepoIndexBucket, TimeIndexBucket} {
+			if err := tx.DeleteBucket(bucket); err != nil {
+				return err
+			}
+			if _, err := tx.CreateBucket(bucket); err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		t.Fatalf("error dropping indices: %s", err)
+	}
+	if err := log.Close(); err != nil {
+		t.Fatalf("error closing oplog: %s", err)
+	}
+
+	log, err = NewBoltStore(dbPath)
+	if err != nil {
+		t.Fatalf("error reopening oplog: %s", err)
+	}
+	t.Cleanup(func() { log.Close() })
+	countByRepoHelper(t, log, "repo1", 2)
+	if got, _ := queryMessages(t, log, Query{StartTimeMs: 1000}); !slices.Equal(got, []string{"op1", "op2"}) {
+		t.Errorf("want operations rebuilt into the time index, got %v", got)
+	}
+}
+
+func TestWriteSnapshot(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	log, err := NewBoltStore(dir + "/test.boltdb")
+	if err != nil {
+		t.Fatalf("error creating oplog: %s", err)
+	}
+	t.Cleanup(func() { log.Close() })
+	if err := log.Add(&v1.Operation{UnixTimeStartMs: 1000, PlanId: "plan1", RepoId: "repo1", DisplayMessage: "op1", Op: &v1.Operation_OperationBackup{}}); err != nil {
+		t.Fatalf("error adding operation: %s", err)
+	}
+
+	f, err := os.Create(dir + "/snapshot.boltdb")
+	if err != nil {
+		t.Fatalf("error creating snapshot file: %s", err)
+	}
+	if _, err := log.WriteSnapshot(f); err != nil {
+		t.Fatalf("error writing snapshot: %s", err)
+	}
+	f.Close()
+
+	// the snapshot is a complete database, it opens while the log is still open.
+	snapshot, err := NewBoltStore(dir + "/snapshot.boltdb")
+	if err != nil {
+		t.Fatalf("error opening snapshot: %s", err)
+	}
+	t.Cleanup(func() { snapshot.Close() })
+	if got, _ := queryMessages(t, snapshot, Query{RepoId: "repo1"}); !slices.Equal(got, []string{"op1"}) {
+		t.Errorf("want snapshot operations [op1], got %v", got)
+	}
+}
+
 func collectMessages(ops []*v1.Operation) []string {
 	var messages []string
 	for _, op := range ops {
@@ -331,7 +407,7 @@ func collectMessages(ops []*v1.Operation) []string {
 	return messages
 }
 
-func countByRepoHelper(t *testing.T, log *OpLog, repo string, expected int) {
+func countByRepoHelper(t *testing.T, log *BoltStore, repo string, expected int) {
 	t.Helper()
 	count := 0
 	if err := log.ForEachByRepo(repo, indexutil.CollectAll(), func(op *v1.Operation) error {
@@ -345,7 +421,7 @@ func countByRepoHelper(t *testing.T, log *OpLog, repo string, expected int) {
 	}
 }
 
-func countByPlanHelper(t *testing.T, log *OpLog, plan string, expected int) {
+func countByPlanHelper(t *testing.T, log *BoltStore, plan string, expected int) {
 	t.Helper()
 	count := 0
 	if err := log.ForEachByPlan(plan, indexutil.CollectAll(), func(op *v1.Operation) error {
@@ -359,7 +435,7 @@ func countByPlanHelper(t *testing.T, log *OpLog, plan string, expected int) {
 	}
 }
 
-func countBySnapshotIdHelper(t *testing.T, log *OpLog, snapshotId string, expected int) {
+func countBySnapshotIdHelper(t *testing.T, log *BoltStore, snapshotId string, expected int) {
 	t.Helper()
 	count := 0
 	if err := log.ForEachBySnapshotId(snapshotId, indexutil.CollectAll(), func(op *v1.Operation) error {
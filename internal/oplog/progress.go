@@ -0,0 +1,83 @@
+package oplog
+
+import (
+	"errors"
+	"time"
+
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+// progressPersistInterval is how often UpdateProgress writes an operation's progress through to the database.
+const progressPersistInterval = 30 * time.Second
+
+type progressEntry struct {
+	op          *v1.Operation
+	persistedAt time.Time
+}
+
+// UpdateProgress records transient progress of an in progress operation. The progress is kept in memory and streamed to subscribers
+// as a transient event, it is only written to the database every progressPersistInterval. Get and Query return the latest progress.
+// The final state of the operation must be stored with Update, which also discards the in memory progress.
+func (o *BoltStore) UpdateProgress(op *v1.Operation) error {
+	return o.updateProgressHelper(op, o.Update)
+}
+
+// updateProgressHelper implements UpdateProgress for a store, update writes the operation through to the store.
+func (o *baseStore) updateProgressHelper(op *v1.Operation, update func(op *v1.Operation) error) error {
+	if op.Id == 0 {
+		return errors.New("operation does not have an ID, OpLog.UpdateProgress expects operation with an ID")
+	}
+
+	now := time.Now()
+	o.progressMu.Lock()
+	entry, ok := o.progress[op.Id]
+	o.progressMu.Unlock()
+	if !ok || now.Sub(entry.persistedAt) >= progressPersistInterval {
+		// the first tick is persisted too so that the interval is measured from a known write.
+		if err := update(op); err != nil {
+			return err
+		}
+		o.setProgressHelper(op, now)
+		return nil
+	}
+
+	o.writeMu.Lock()
+	defer o.writeMu.Unlock()
+	clone := o.setProgressHelper(op, entry.persistedAt)
+	o.notifyHelper(&v1.OperationEvent{
+		Type:      v1.OperationEventType_EVENT_UPDATED,
+		Operation: clone,
+		Transient: true,
+	})
+	return nil
+}
+
+func (o *baseStore) setProgressHelper(op *v1.Operation, persistedAt time.Time) *v1.Operation {
+	clone := proto.Clone(op).(*v1.Operation)
+	o.progressMu.Lock()
+	defer o.progressMu.Unlock()
+	if o.progress == nil {
+		o.progress = make(map[int64]*progressEntry)
+	}
+	o.progress[op.Id] = &progressEntry{op: clone, persistedAt: persistedAt}
+	return clone
+}
+
+func (o *baseStore) clearProgressHelper(ids ...int64) {
+	o.progressMu.Lock()
+	defer o.progressMu.Unlock()
+	for _, id := range ids {
+		delete(o.progress, id)
+	}
+}
+
+// withProgressHelper returns the latest in memory progress of the operation if there is any, otherwise the operation itself.
+func (o *baseStore) withProgressHelper(op *v1.Operation) *v1.Operation {
+	o.progressMu.Lock()
+	defer o.progressMu.Unlock()
+	if entry, ok := o.progress[op.Id]; ok {
+		return proto.Clone(entry.op).(*v1.Operation)
+	}
+	return op
+}
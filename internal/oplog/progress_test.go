@@ -0,0 +1,97 @@
+package oplog
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+	bolt "go.etcd.io/bbolt"
+)
+
+func getPersisted(t *testing.T, log *BoltStore, id int64) *v1.Operation {
+	t.Helper()
+	var op *v1.Operation
+	if err := log.db.View(func(tx *bolt.Tx) error {
+		var err error
+		op, err = log.getOperationHelper(tx.Bucket(OpLogBucket), id)
+		return err
+	}); err != nil {
+		t.Fatalf("error getting persisted operation: %s", err)
+	}
+	return op
+}
+
+func TestUpdateProgress(t *testing.T) {
+	t.Parallel()
+	log, err := NewBoltStore(t.TempDir() + "/test.boltdb")
+	if err != nil {
+		t.Fatalf("error creating oplog: %s", err)
+	}
+	t.Cleanup(func() { log.Close() })
+
+	op := &v1.Operation{UnixTimeStartMs: 1000, PlanId: "plan1", RepoId: "repo1", Status: v1.OperationStatus_STATUS_INPROGRESS, Op: &v1.Operation_OperationBackup{}}
+	if err := log.Add(op); err != nil {
+		t.Fatalf("error adding operation: %s", err)
+	}
+	sub := log.Subscribe(10, OverflowDisconnect)
+	defer sub.Close()
+
+	// the first tick is written through, the following ticks within the interval are kept in memory.
+	op.DisplayMessage = "tick 1"
+	if err := log.UpdateProgress(op); err != nil {
+		t.Fatalf("error updating progress: %s", err)
+	}
+	op.DisplayMessage = "tick 2"
+	if err := log.UpdateProgress(op); err != nil {
+		t.Fatalf("error updating progress: %s", err)
+	}
+
+	if got := getPersisted(t, log, op.Id).DisplayMessage; got != "tick 1" {
+		t.Errorf("want persisted message %q, got %q", "tick 1", got)
+	}
+	if got, err := log.Get(op.Id); err != nil || got.DisplayMessage != "tick 2" {
+		t.Errorf("want Get to return the latest progress %q, got %v, err: %v", "tick 2", got, err)
+	}
+	if got, _ := queryMessages(t, log, Query{RepoId: "repo1"}); !slices.Equal(got, []string{"tick 2"}) {
+		t.Errorf("want Query to return the latest progress, got %v", got)
+	}
+
+	first, _ := sub.Next(context.Background())
+	second, _ := sub.Next(context.Background())
+	if first.Transient || first.Seq != 2 || !second.Transient || second.Operation.DisplayMessage != "tick 2" {
+		t.Errorf("want a persisted event followed by a transient event, got %v and %v", first, second)
+	}
+
+	op.DisplayMessage = "done"
+	op.Status = v1.OperationStatus_STATUS_SUCCESS
+	if err := log.Update(op); err != nil {
+		t.Fatalf("error updating operation: %s", err)
+	}
+	if got, err := log.Get(op.Id); err != nil || got.DisplayMessage != "done" {
+		t.Errorf("want the final state after Update, got %v, err: %v", got, err)
+	}
+}
+
+func TestUpdateKeepsIndices(t *testing.T) {
+	t.Parallel()
+	log, err := NewBoltStore(t.TempDir() + "/test.boltdb")
+	if err != nil {
+		t.Fatalf("error creating oplog: %s", err)
+	}
+	t.Cleanup(func() { log.Close() })
+
+	op := &v1.Operation{UnixTimeStartMs: 1000, PlanId: "plan1", RepoId: "repo1", DisplayMessage: "op1", Status: v1.OperationStatus_STATUS_INPROGRESS, Op: &v1.Operation_OperationBackup{}}
+	if err := log.Add(op); err != nil {
+		t.Fatalf("error adding operation: %s", err)
+	}
+	op.DisplayMessage = "op1 updated"
+	if err := log.Update(op); err != nil {
+		t.Fatalf("error updating operation: %s", err)
+	}
+
+	got, _ := queryMessages(t, log, Query{RepoId: "repo1", PlanId: "plan1", StartTimeMs: 1000, Statuses: []v1.OperationStatus{v1.OperationStatus_STATUS_INPROGRESS}, Types: []v1.OperationType{v1.OperationType_TYPE_BACKUP}})
+	if want := []string{"op1 updated"}; !slices.Equal(got, want) {
+		t.Errorf("want operations: %v, got unexpected operations: %v", want, got)
+	}
+}
@@ -0,0 +1,154 @@
+package oplog
+
+import (
+	"math"
+	"sort"
+	"strings"
+
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+	"github.com/garethgeorge/backrest/internal/oplog/indexutil"
+	"github.com/garethgeorge/backrest/internal/oplog/serializationutil"
+	bolt "go.etcd.io/bbolt"
+)
+
+// Query selects operations from the log, an operation must match every field that is set.
+type Query struct {
+	RepoId      string
+	PlanId      string
+	SnapshotId  string
+	Statuses    []v1.OperationStatus // operations with any of the statuses.
+	Types       []v1.OperationType   // operations of any of the types.
+	StartTimeMs int64                // operations that started at or after this time.
+	EndTimeMs   int64                // operations that started before this time.
+	Search      string               // case insensitive substring of the operation's display message.
+
+	Cursor  int64 // only operations after this id in iteration order, typically the cursor returned by a previous query.
+	Limit   int   // maximum number of operations to visit.
+	Reverse bool  // visit operations ordered by id descending.
+}
+
+func (q *Query) matches(op *v1.Operation) bool {
+	if q.Search != "" && !strings.Contains(strings.ToLower(op.DisplayMessage), strings.ToLower(q.Search)) {
+		return false
+	}
+	return true
+}
+
+// Query calls do for each operation matching the query ordered by id. Filters are resolved by joining their indices,
+// only the text search is applied to the candidates. If the limit is reached and more operations match, the id of
+// the last visited operation is returned as the cursor for the next page, otherwise 0.
+func (o *BoltStore) Query(q Query, do func(op *v1.Operation) error) (int64, error) {
+	var nextCursor int64
+	err := o.db.View(func(tx *bolt.Tx) error {
+		b := tx.Bucket(OpLogBucket)
+		nextId := o.queryIdsHelper(tx, &q)
+
+		var err error
+		nextCursor, err = o.visitHelper(&q, func() (*v1.Operation, error) {
+			id, ok := nextId()
+			if !ok {
+				return nil, nil
+			}
+			return o.getOperationHelper(b, id)
+		}, do)
+		return err
+	})
+	return nextCursor, err
+}
+
+// queryIdsHelper returns a function yielding the candidate ids for the query in iteration order starting after the cursor.
+func (o *BoltStore) queryIdsHelper(tx *bolt.Tx, q *Query) func() (int64, bool) {
+	var iters []indexutil.IndexIterator
+	if q.RepoId != "" {
+		iters = append(iters, indexutil.IndexSearchByteValue(tx.Bucket(RepoIndexBucket), []byte(q.RepoId)))
+	}
+	if q.PlanId != "" {
+		iters = append(iters, indexutil.IndexSearchByteValue(tx.Bucket(PlanIndexBucket), []byte(q.PlanId)))
+	}
+	if q.SnapshotId != "" {
+		iters = append(iters, indexutil.IndexSearchByteValue(tx.Bucket(SnapshotIndexBucket), []byte(q.SnapshotId)))
+	}
+	if q.StartTimeMs != 0 || q.EndTimeMs != 0 {
+		end := q.EndTimeMs
+		if end == 0 {
+			end = math.MaxInt64
+		}
+		iters = append(iters, indexutil.IndexSearchByteRange(tx.Bucket(TimeIndexBucket), serializationutil.Itob(q.StartTimeMs), serializationutil.Itob(end)))
+	}
+
+	if len(q.Statuses) > 0 {
+		var statusIters []indexutil.IndexIterator
+		for _, status := range q.Statuses {
+			statusIters = append(statusIters, indexutil.IndexSearchByteValue(tx.Bucket(StatusIndexBucket), serializationutil.Itob(int64(status))))
+		}
+		iters = append(iters, indexutil.NewUnionIterator(statusIters...))
+	}
+	if len(q.Types) > 0 {
+		var typeIters []indexutil.IndexIterator
+		for _, opType := range q.Types {
+			typeIters = append(typeIters, indexutil.IndexSearchByteValue(tx.Bucket(TypeIndexBucket), serializationutil.Itob(int64(opType))))
+		}
+		iters = append(iters, indexutil.NewUnionIterator(typeIters...))
+	}
+
+	if len(iters) == 0 {
+		return logCursorHelper(tx.Bucket(OpLogBucket).Cursor(), q.Cursor, q.Reverse)
+	}
+
+	ids := indexutil.CollectAll()(indexutil.NewJoinIterator(iters...))
+	if !q.Reverse {
+		idx := sort.Search(len(ids), func(i int) bool { return ids[i] > q.Cursor })
+		return func() (int64, bool) {
+			if idx >= len(ids) {
+				return 0, false
+			}
+			idx++
+			return ids[idx-1], true
+		}
+	}
+
+	idx := len(ids) - 1
+	if q.Cursor != 0 {
+		idx = sort.Search(len(ids), func(i int) bool { return ids[i] >= q.Cursor }) - 1
+	}
+	return func() (int64, bool) {
+		if idx < 0 {
+			return 0, false
+		}
+		idx--
+		return ids[idx+1], true
+	}
+}
+
+// logCursorHelper walks the ids of the log bucket directly, used when no index narrows down the candidates.
+func logCursorHelper(c *bolt.Cursor, cursor int64, reverse bool) func() (int64, bool) {
+	var k []byte
+	started := false
+	return func() (int64, bool) {
+		if !started {
+			started = true
+			if !reverse {
+				k, _ = c.Seek(serializationutil.Itob(cursor + 1))
+			} else if cursor == 0 {
+				k, _ = c.Last()
+			} else if k, _ = c.Seek(serializationutil.Itob(cursor)); k == nil {
+				k, _ = c.Last()
+			} else {
+				k, _ = c.Prev()
+			}
+		} else if !reverse {
+			k, _ = c.Next()
+		} else {
+			k, _ = c.Prev()
+		}
+
+		if k == nil {
+			return 0, false
+		}
+		id, err := serializationutil.Btoi(k)
+		if err != nil {
+			return 0, false
+		}
+		return id, true
+	}
+}
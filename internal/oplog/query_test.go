@@ -0,0 +1,238 @@
+package oplog
+
+import (
+	"slices"
+	"testing"
+
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+	bolt "go.etcd.io/bbolt"
+)
+
+func addQueryTestOps(t *testing.T, log OpLog) {
+	t.Helper()
+	ops := []*v1.Operation{
+		{UnixTimeStartMs: 1000, PlanId: "plan1", RepoId: "repo1", DisplayMessage: "op1", Status: v1.OperationStatus_STATUS_SUCCESS, Op: &v1.Operation_OperationBackup{}},
+		{UnixTimeStartMs: 2000, PlanId: "plan1", RepoId: "repo2", DisplayMessage: "op2", Status: v1.OperationStatus_STATUS_ERROR, Op: &v1.Operation_OperationBackup{}},
+		{UnixTimeStartMs: 3000, PlanId: "plan2", RepoId: "repo2", DisplayMessage: "op3 Failed", Status: v1.OperationStatus_STATUS_ERROR, Op: &v1.Operation_OperationPrune{}},
+		{UnixTimeStartMs: 4000, PlanId: "plan1", RepoId: "repo1", DisplayMessage: "op4 failed", Status: v1.OperationStatus_STATUS_ERROR, Op: &v1.Operation_OperationBackup{}},
+		{UnixTimeStartMs: 5000, PlanId: "plan1", RepoId: "repo1", DisplayMessage: "op5", Status: v1.OperationStatus_STATUS_SUCCESS, Op: &v1.Operation_OperationForget{}},
+	}
+	if err := log.BulkAdd(ops); err != nil {
+		t.Fatalf("error adding operations: %s", err)
+	}
+}
+
+func queryMessages(t *testing.T, log OpLog, q Query) ([]string, int64) {
+	t.Helper()
+	var ops []*v1.Operation
+	cursor, err := log.Query(q, func(op *v1.Operation) error {
+		ops = append(ops, op)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("error querying operations: %s", err)
+	}
+	return collectMessages(ops), cursor
+}
+
+func TestQuery(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		query    Query
+		expected []string
+	}{
+		{
+			name:     "all",
+			query:    Query{},
+			expected: []string{"op1", "op2", "op3 Failed", "op4 failed", "op5"},
+		},
+		{
+			name:     "all reversed",
+			query:    Query{Reverse: true},
+			expected: []string{"op5", "op4 failed", "op3 Failed", "op2", "op1"},
+		},
+		{
+			name:     "repo and plan",
+			query:    Query{RepoId: "repo1", PlanId: "plan1"},
+			expected: []string{"op1", "op4 failed", "op5"},
+		},
+		{
+			name:     "status",
+			query:    Query{PlanId: "plan1", Statuses: []v1.OperationStatus{v1.OperationStatus_STATUS_ERROR}},
+			expected: []string{"op2", "op4 failed"},
+		},
+		{
+			name:     "type",
+			query:    Query{Types: []v1.OperationType{v1.OperationType_TYPE_PRUNE, v1.OperationType_TYPE_FORGET}},
+			expected: []string{"op3 Failed", "op5"},
+		},
+		{
+			name:     "time range",
+			query:    Query{StartTimeMs: 2000, EndTimeMs: 4000},
+			expected: []string{"op2", "op3 Failed"},
+		},
+		{
+			name:     "time range and repo",
+			query:    Query{RepoId: "repo1", StartTimeMs: 2000},
+			expected: []string{"op4 failed", "op5"},
+		},
+		{
+			name:     "search is case insensitive",
+			query:    Query{Search: "FAILED"},
+			expected: []string{"op3 Failed", "op4 failed"},
+		},
+		{
+			name:     "no matches",
+			query:    Query{RepoId: "repo1", PlanId: "plan2"},
+			expected: nil,
+		},
+	}
+
+	forEachTestStore(t, func(t *testing.T, log OpLog) {
+		addQueryTestOps(t, log)
+		for _, tc := range tests {
+			tc := tc
+			t.Run(tc.name, func(t *testing.T) {
+				got, _ := queryMessages(t, log, tc.query)
+				if !slices.Equal(got, tc.expected) {
+					t.Errorf("want operations: %v, got unexpected operations: %v", tc.expected, got)
+				}
+			})
+		}
+	})
+}
+
+func TestQueryPagination(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		query    Query
+		expected [][]string
+	}{
+		{
+			name:     "forward",
+			query:    Query{Limit: 2},
+			expected: [][]string{{"op1", "op2"}, {"op3 Failed", "op4 failed"}, {"op5"}},
+		},
+		{
+			name:     "reverse",
+			query:    Query{Limit: 2, Reverse: true},
+			expected: [][]string{{"op5", "op4 failed"}, {"op3 Failed", "op2"}, {"op1"}},
+		},
+		{
+			name:     "filtered",
+			query:    Query{RepoId: "repo1", Limit: 2},
+			expected: [][]string{{"op1", "op4 failed"}, {"op5"}},
+		},
+		{
+			name:     "filtered reverse",
+			query:    Query{RepoId: "repo1", Limit: 2, Reverse: true},
+			expected: [][]string{{"op5", "op4 failed"}, {"op1"}},
+		},
+		{
+			name:     "exact page",
+			query:    Query{RepoId: "repo2", Limit: 2},
+			expected: [][]string{{"op2", "op3 Failed"}},
+		},
+	}
+
+	forEachTestStore(t, func(t *testing.T, log OpLog) {
+		addQueryTestOps(t, log)
+		for _, tc := range tests {
+			tc := tc
+			t.Run(tc.name, func(t *testing.T) {
+				q := tc.query
+				var pages [][]string
+				for {
+					page, cursor := queryMessages(t, log, q)
+					pages = append(pages, page)
+					if cursor == 0 {
+						break
+					}
+					if len(pages) > len(tc.expected) {
+						t.Fatalf("too many pages, got: %v", pages)
+					}
+					q.Cursor = cursor
+				}
+				if !slices.EqualFunc(pages, tc.expected, slices.Equal[[]string]) {
+					t.Errorf("want pages: %v, got: %v", tc.expected, pages)
+				}
+			})
+		}
+	})
+}
+
+func TestIndexBackfill(t *testing.T) {
+	t.Parallel()
+	path := t.TempDir() + "/test.boltdb"
+	log, err := NewBoltStore(path)
+	if err != nil {
+		t.Fatalf("error creating oplog: %s", err)
+	}
+	addQueryTestOps(t, log)
+
+	// simulate a database created before the indices existed.
+	if err := log.db.Update(func(tx *bolt.Tx) error {
+		for _, bucket := range indexBuckets {
+			if err := tx.DeleteBucket(bucket); err != nil {
+				return err
+			}
+		}
+		return tx.Bucket(SystemBucket).Delete(indexVersionKey)
+	}); err != nil {
+		t.Fatalf("error dropping indices: %s", err)
+	}
+	if err := log.Close(); err != nil {
+		t.Fatalf("error closing oplog: %s", err)
+	}
+
+	log, err = NewBoltStore(path)
+	if err != nil {
+		t.Fatalf("error reopening oplog: %s", err)
+	}
+	t.Cleanup(func() { log.Close() })
+
+	got, _ := queryMessages(t, log, Query{RepoId: "repo1", StartTimeMs: 2000})
+	if want := []string{"op4 failed", "op5"}; !slices.Equal(got, want) {
+		t.Errorf("want operations: %v, got unexpected operations: %v", want, got)
+	}
+	got, _ = queryMessages(t, log, Query{Statuses: []v1.OperationStatus{v1.OperationStatus_STATUS_ERROR}, Types: []v1.OperationType{v1.OperationType_TYPE_BACKUP}})
+	if want := []string{"op2", "op4 failed"}; !slices.Equal(got, want) {
+		t.Errorf("want operations: %v, got unexpected operations: %v", want, got)
+	}
+}
+
+func TestQueryAfterUpdate(t *testing.T) {
+	t.Parallel()
+	forEachTestStore(t, func(t *testing.T, log OpLog) {
+		op := &v1.Operation{
+			UnixTimeStartMs: 1000,
+			PlanId:          "plan1",
+			RepoId:          "repo1",
+			DisplayMessage:  "op1",
+			Status:          v1.OperationStatus_STATUS_INPROGRESS,
+			Op:              &v1.Operation_OperationBackup{},
+		}
+		if err := log.Add(op); err != nil {
+			t.Fatalf("error adding operation: %s", err)
+		}
+
+		op.Status = v1.OperationStatus_STATUS_SUCCESS
+		op.Op = &v1.Operation_OperationPrune{}
+		if err := log.Update(op); err != nil {
+			t.Fatalf("error updating operation: %s", err)
+		}
+
+		if got, _ := queryMessages(t, log, Query{Statuses: []v1.OperationStatus{v1.OperationStatus_STATUS_INPROGRESS}}); len(got) != 0 {
+			t.Errorf("want no in progress operations, got: %v", got)
+		}
+		if got, _ := queryMessages(t, log, Query{Types: []v1.OperationType{v1.OperationType_TYPE_BACKUP}}); len(got) != 0 {
+			t.Errorf("want no backup operations, got: %v", got)
+		}
+		got, _ := queryMessages(t, log, Query{Statuses: []v1.OperationStatus{v1.OperationStatus_STATUS_SUCCESS}, Types: []v1.OperationType{v1.OperationType_TYPE_PRUNE}})
+		if want := []string{"op1"}; !slices.Equal(got, want) {
+			t.Errorf("want operations: %v, got unexpected operations: %v", want, got)
+		}
+	})
+}
@@ -0,0 +1,470 @@
+//go:build cgo
+
+package oplog
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path"
+	"strings"
+	"time"
+
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+	"github.com/garethgeorge/backrest/internal/protoutil"
+	_ "github.com/mattn/go-sqlite3"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// sqliteSchema creates the tables of a SqliteStore. Operations are stored as protojson so that they can be inspected with
+// SQLite's json functions, the columns beside the operation hold the indexed fields.
+const sqliteSchema = `
+CREATE TABLE IF NOT EXISTS operations (
+	id INTEGER PRIMARY KEY,
+	repo_id TEXT NOT NULL,
+	plan_id TEXT NOT NULL,
+	snapshot_id TEXT NOT NULL,
+	start_time_ms INTEGER NOT NULL,
+	type INTEGER NOT NULL,
+	status INTEGER NOT NULL,
+	operation TEXT NOT NULL
+);
+CREATE INDEX IF NOT EXISTS operations_repo_id ON operations (repo_id);
+CREATE INDEX IF NOT EXISTS operations_plan_id ON operations (plan_id);
+CREATE INDEX IF NOT EXISTS operations_snapshot_id ON operations (snapshot_id);
+CREATE INDEX IF NOT EXISTS operations_start_time_ms ON operations (start_time_ms);
+CREATE INDEX IF NOT EXISTS operations_type ON operations (type);
+CREATE INDEX IF NOT EXISTS operations_status ON operations (status);
+CREATE TABLE IF NOT EXISTS journal (
+	seq INTEGER PRIMARY KEY,
+	event BLOB NOT NULL
+);
+CREATE TABLE IF NOT EXISTS system (
+	key TEXT PRIMARY KEY,
+	value INTEGER NOT NULL
+);
+`
+
+// keys of the system table.
+const (
+	sqliteIdSeqKey    = "id_seq"
+	sqliteEventSeqKey = "event_seq"
+)
+
+// SqliteStore is an OpLog stored in a SQLite database, the database can be queried with SQL tools while backrest is running.
+// It requires a build with cgo enabled.
+type SqliteStore struct {
+	baseStore
+	db *sql.DB
+}
+
+var _ OpLog = &SqliteStore{}
+
+func NewSqliteStore(databasePath string) (*SqliteStore, error) {
+	if err := os.MkdirAll(path.Dir(databasePath), 0700); err != nil {
+		return nil, fmt.Errorf("error creating database directory: %s", err)
+	}
+
+	// WAL lets readers, including external SQL tools, run alongside the writer. Writes are serialized by writeMu.
+	db, err := sql.Open("sqlite3", "file:"+databasePath+"?_journal_mode=WAL&_busy_timeout=5000&_synchronous=NORMAL")
+	if err != nil {
+		return nil, fmt.Errorf("error opening database: %s", err)
+	}
+	if _, err := db.Exec(sqliteSchema); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("error creating tables: %w", err)
+	}
+
+	return &SqliteStore{
+		db: db,
+	}, nil
+}
+
+// WriteSnapshot writes a consistent copy of the database to w while the log stays available for reads and writes. The copy is
+// made with VACUUM INTO a temporary file.
+func (o *SqliteStore) WriteSnapshot(w io.Writer) (int64, error) {
+	dir, err := os.MkdirTemp("", "backrest-oplog-snapshot-")
+	if err != nil {
+		return 0, fmt.Errorf("create temp dir: %w", err)
+	}
+	defer os.RemoveAll(dir)
+
+	file := path.Join(dir, "oplog.sqlite")
+	if _, err := o.db.Exec("VACUUM INTO ?", file); err != nil {
+		return 0, fmt.Errorf("vacuum into %v: %w", file, err)
+	}
+	f, err := os.Open(file)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+	return io.Copy(w, f)
+}
+
+func (o *SqliteStore) Close() error {
+	return o.db.Close()
+}
+
+func (o *SqliteStore) Add(op *v1.Operation) error {
+	if op.Id != 0 {
+		return errors.New("operation already has an ID, OpLog.Add is expected to set the ID")
+	}
+	return o.BulkAdd([]*v1.Operation{op})
+}
+
+func (o *SqliteStore) BulkAdd(ops []*v1.Operation) error {
+	for _, op := range ops {
+		if op.Id != 0 {
+			return errors.New("operation already has an ID, OpLog.BulkAdd is expected to set the ID")
+		}
+	}
+	return o.addHelper(ops, func(op *v1.Operation) int64 { return time.Now().UnixMilli() })
+}
+
+func (o *SqliteStore) Insert(ops []*v1.Operation) error {
+	return o.addHelper(ops, func(op *v1.Operation) int64 {
+		if op.UnixTimeStartMs <= 0 {
+			return time.Now().UnixMilli()
+		}
+		return op.UnixTimeStartMs
+	})
+}
+
+// addHelper assigns each operation an unused id created at idTime(op) and adds the operations in a single transaction.
+func (o *SqliteStore) addHelper(ops []*v1.Operation, idTime func(op *v1.Operation) int64) error {
+	o.writeMu.Lock()
+	defer o.writeMu.Unlock()
+
+	var events []*v1.OperationEvent
+	err := o.transactHelper(func(tx *sql.Tx) error {
+		for _, op := range ops {
+			for {
+				seq, err := o.incrementHelper(tx, sqliteIdSeqKey)
+				if err != nil {
+					return fmt.Errorf("create next operation ID: %w", err)
+				}
+				op.Id = operationId(idTime(op), uint64(seq))
+				var exists bool
+				if err := tx.QueryRow("SELECT EXISTS(SELECT 1 FROM operations WHERE id = ?)", op.Id).Scan(&exists); err != nil {
+					return fmt.Errorf("create next operation ID: %w", err)
+				} else if !exists {
+					break
+				}
+			}
+			if err := o.putOperationHelper(tx, op); err != nil {
+				return err
+			}
+			event, err := o.journalHelper(tx, v1.OperationEventType_EVENT_CREATED, op)
+			if err != nil {
+				return err
+			}
+			events = append(events, event)
+		}
+		return nil
+	})
+	if err == nil {
+		o.notifyHelper(events...)
+	}
+	return err
+}
+
+func (o *SqliteStore) Update(op *v1.Operation) error {
+	if op.Id == 0 {
+		return errors.New("operation does not have an ID, OpLog.Update expects operation with an ID")
+	}
+	o.writeMu.Lock()
+	defer o.writeMu.Unlock()
+
+	var event *v1.OperationEvent
+	err := o.transactHelper(func(tx *sql.Tx) error {
+		if _, err := o.getOperationHelper(tx, op.Id); err != nil {
+			return fmt.Errorf("getting existing value prior to update: %w", err)
+		}
+		if err := o.putOperationHelper(tx, op); err != nil {
+			return fmt.Errorf("putting updated value: %w", err)
+		}
+		var err error
+		event, err = o.journalHelper(tx, v1.OperationEventType_EVENT_UPDATED, op)
+		return err
+	})
+	if err == nil {
+		o.clearProgressHelper(op.Id)
+		o.notifyHelper(event)
+	}
+	return err
+}
+
+func (o *SqliteStore) UpdateProgress(op *v1.Operation) error {
+	return o.updateProgressHelper(op, o.Update)
+}
+
+func (o *SqliteStore) Delete(ids ...int64) error {
+	o.writeMu.Lock()
+	defer o.writeMu.Unlock()
+
+	events := make([]*v1.OperationEvent, 0, len(ids))
+	err := o.transactHelper(func(tx *sql.Tx) error {
+		for _, id := range ids {
+			removed, err := o.getOperationHelper(tx, id)
+			if err != nil {
+				return fmt.Errorf("deleting operation %v: %w", id, err)
+			}
+			if _, err := tx.Exec("DELETE FROM operations WHERE id = ?", id); err != nil {
+				return fmt.Errorf("deleting operation %v: %w", id, err)
+			}
+			event, err := o.journalHelper(tx, v1.OperationEventType_EVENT_DELETED, removed)
+			if err != nil {
+				return err
+			}
+			events = append(events, event)
+		}
+		return nil
+	})
+	if err == nil {
+		o.clearProgressHelper(ids...)
+		o.notifyHelper(events...)
+	}
+	return err
+}
+
+// transactHelper runs do in a transaction, committing it if do succeeds.
+func (o *SqliteStore) transactHelper(do func(tx *sql.Tx) error) error {
+	tx, err := o.db.Begin()
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+	if err := do(tx); err != nil {
+		tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+// incrementHelper increments the counter stored under key in the system table and returns its new value.
+func (o *SqliteStore) incrementHelper(tx *sql.Tx, key string) (int64, error) {
+	var value int64
+	if err := tx.QueryRow("INSERT INTO system (key, value) VALUES (?, 1) ON CONFLICT (key) DO UPDATE SET value = value + 1 RETURNING value", key).Scan(&value); err != nil {
+		return 0, fmt.Errorf("increment %s: %w", key, err)
+	}
+	return value, nil
+}
+
+// journalHelper assigns the next seq to the event and appends it to the journal, dropping the entries older than journalMaxEntries.
+func (o *SqliteStore) journalHelper(tx *sql.Tx, eventType v1.OperationEventType, op *v1.Operation) (*v1.OperationEvent, error) {
+	seq, err := o.incrementHelper(tx, sqliteEventSeqKey)
+	if err != nil {
+		return nil, err
+	}
+	event := newEventHelper(eventType, op, seq)
+	bytes, err := proto.Marshal(event)
+	if err != nil {
+		return nil, fmt.Errorf("error marshalling event: %w", err)
+	}
+	if _, err := tx.Exec("INSERT INTO journal (seq, event) VALUES (?, ?)", seq, bytes); err != nil {
+		return nil, fmt.Errorf("error putting event into journal: %w", err)
+	}
+	if _, err := tx.Exec("DELETE FROM journal WHERE seq <= ?", seq-journalMaxEntries); err != nil {
+		return nil, fmt.Errorf("error trimming journal: %w", err)
+	}
+	return event, nil
+}
+
+// putOperationHelper inserts or replaces the operation.
+func (o *SqliteStore) putOperationHelper(tx *sql.Tx, op *v1.Operation) error {
+	if err := protoutil.ValidateOperation(op); err != nil {
+		return fmt.Errorf("validating operation: %w", err)
+	}
+	bytes, err := protojson.Marshal(op)
+	if err != nil {
+		return fmt.Errorf("error marshalling operation: %w", err)
+	}
+	if _, err := tx.Exec("INSERT OR REPLACE INTO operations (id, repo_id, plan_id, snapshot_id, start_time_ms, type, status, operation) VALUES (?, ?, ?, ?, ?, ?, ?, ?)",
+		op.Id, op.RepoId, op.PlanId, op.SnapshotId, op.UnixTimeStartMs, int64(protoutil.OperationType(op)), int64(op.Status), string(bytes)); err != nil {
+		return fmt.Errorf("error putting operation into table: %w", err)
+	}
+	return nil
+}
+
+func (o *SqliteStore) getOperationHelper(tx *sql.Tx, id int64) (*v1.Operation, error) {
+	var data string
+	if err := tx.QueryRow("SELECT operation FROM operations WHERE id = ?", id).Scan(&data); errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("opid %v: %w", id, ErrNotExist)
+	} else if err != nil {
+		return nil, fmt.Errorf("getting operation %v: %w", id, err)
+	}
+	return unmarshalSqliteOperation(data)
+}
+
+func unmarshalSqliteOperation(data string) (*v1.Operation, error) {
+	op := &v1.Operation{}
+	if err := protojson.Unmarshal([]byte(data), op); err != nil {
+		return nil, fmt.Errorf("error unmarshalling operation: %w", err)
+	}
+	return op, nil
+}
+
+func (o *SqliteStore) Get(id int64) (*v1.Operation, error) {
+	var data string
+	if err := o.db.QueryRow("SELECT operation FROM operations WHERE id = ?", id).Scan(&data); errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("opid %v: %w", id, ErrNotExist)
+	} else if err != nil {
+		return nil, fmt.Errorf("getting operation %v: %w", id, err)
+	}
+	op, err := unmarshalSqliteOperation(data)
+	if err != nil {
+		return nil, err
+	}
+	return o.withProgressHelper(op), nil
+}
+
+// Query calls do for each operation matching the query ordered by id, see BoltStore.Query. The filters besides the text search
+// are resolved by SQLite with the indices of the operations table.
+func (o *SqliteStore) Query(q Query, do func(op *v1.Operation) error) (int64, error) {
+	var where []string
+	var args []any
+	if q.RepoId != "" {
+		where, args = append(where, "repo_id = ?"), append(args, q.RepoId)
+	}
+	if q.PlanId != "" {
+		where, args = append(where, "plan_id = ?"), append(args, q.PlanId)
+	}
+	if q.SnapshotId != "" {
+		where, args = append(where, "snapshot_id = ?"), append(args, q.SnapshotId)
+	}
+	if q.StartTimeMs != 0 || q.EndTimeMs != 0 {
+		end := q.EndTimeMs
+		if end == 0 {
+			end = math.MaxInt64
+		}
+		where, args = append(where, "start_time_ms >= ? AND start_time_ms < ?"), append(args, q.StartTimeMs, end)
+	}
+	if len(q.Statuses) > 0 {
+		where = append(where, "status IN (?"+strings.Repeat(", ?", len(q.Statuses)-1)+")")
+		for _, status := range q.Statuses {
+			args = append(args, int64(status))
+		}
+	}
+	if len(q.Types) > 0 {
+		where = append(where, "type IN (?"+strings.Repeat(", ?", len(q.Types)-1)+")")
+		for _, opType := range q.Types {
+			args = append(args, int64(opType))
+		}
+	}
+	order := "ASC"
+	if q.Reverse {
+		order = "DESC"
+		if q.Cursor != 0 {
+			where, args = append(where, "id < ?"), append(args, q.Cursor)
+		}
+	} else {
+		where, args = append(where, "id > ?"), append(args, q.Cursor)
+	}
+
+	stmt := "SELECT operation FROM operations"
+	if len(where) > 0 {
+		stmt += " WHERE " + strings.Join(where, " AND ")
+	}
+	rows, err := o.db.Query(stmt+" ORDER BY id "+order, args...)
+	if err != nil {
+		return 0, fmt.Errorf("querying operations: %w", err)
+	}
+	defer rows.Close()
+
+	cursor, err := o.visitHelper(&q, func() (*v1.Operation, error) {
+		if !rows.Next() {
+			return nil, rows.Err()
+		}
+		var data string
+		if err := rows.Scan(&data); err != nil {
+			return nil, err
+		}
+		return unmarshalSqliteOperation(data)
+	}, do)
+	if err != nil {
+		return 0, err
+	}
+	return cursor, nil
+}
+
+func (o *SqliteStore) CountByPlan() (map[string]int, error) {
+	rows, err := o.db.Query("SELECT plan_id, COUNT(*) FROM operations GROUP BY plan_id")
+	if err != nil {
+		return nil, fmt.Errorf("counting operations: %w", err)
+	}
+	defer rows.Close()
+
+	counts := make(map[string]int)
+	for rows.Next() {
+		var planId string
+		var count int
+		if err := rows.Scan(&planId, &count); err != nil {
+			return nil, fmt.Errorf("counting operations: %w", err)
+		}
+		counts[planId] = count
+	}
+	return counts, rows.Err()
+}
+
+func (o *SqliteStore) Scan(onIncomplete func(op *v1.Operation)) error {
+	return scanHelper(o, onIncomplete)
+}
+
+func (o *SqliteStore) EventsSince(seq int64) ([]*v1.OperationEvent, int64, error) {
+	tx, err := o.db.Begin()
+	if err != nil {
+		return nil, 0, fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	var currentSeq int64
+	if err := tx.QueryRow("SELECT value FROM system WHERE key = ?", sqliteEventSeqKey).Scan(&currentSeq); err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, 0, fmt.Errorf("get event seq: %w", err)
+	}
+	if seq == currentSeq {
+		return nil, currentSeq, nil
+	} else if seq > currentSeq {
+		return nil, currentSeq, ErrResyncRequired
+	}
+
+	rows, err := tx.Query("SELECT seq, event FROM journal WHERE seq > ? ORDER BY seq", seq)
+	if err != nil {
+		return nil, currentSeq, fmt.Errorf("querying journal: %w", err)
+	}
+	defer rows.Close()
+
+	var events []*v1.OperationEvent
+	for rows.Next() {
+		var eventSeq int64
+		var bytes []byte
+		if err := rows.Scan(&eventSeq, &bytes); err != nil {
+			return nil, currentSeq, fmt.Errorf("querying journal: %w", err)
+		}
+		if len(events) == 0 && eventSeq != seq+1 {
+			return nil, currentSeq, ErrResyncRequired
+		}
+		event := &v1.OperationEvent{}
+		if err := proto.Unmarshal(bytes, event); err != nil {
+			return nil, currentSeq, fmt.Errorf("error unmarshalling event: %w", err)
+		}
+		events = append(events, event)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, currentSeq, fmt.Errorf("querying journal: %w", err)
+	}
+	if len(events) == 0 {
+		return nil, currentSeq, ErrResyncRequired
+	}
+	return events, currentSeq, nil
+}
+
+func (o *SqliteStore) CurrentSeq() (int64, error) {
+	var seq int64
+	if err := o.db.QueryRow("SELECT value FROM system WHERE key = ?", sqliteEventSeqKey).Scan(&seq); err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return 0, fmt.Errorf("get event seq: %w", err)
+	}
+	return seq, nil
+}
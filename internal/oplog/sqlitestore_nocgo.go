@@ -0,0 +1,13 @@
+//go:build !cgo
+
+package oplog
+
+// SqliteStore is not available in builds without cgo, which don't include the SQLite driver.
+type SqliteStore struct {
+	OpLog
+}
+
+// NewSqliteStore fails with ErrSqliteUnsupported, the SQLite driver requires a build with cgo enabled.
+func NewSqliteStore(databasePath string) (*SqliteStore, error) {
+	return nil, ErrSqliteUnsupported
+}
@@ -0,0 +1,154 @@
+package oplog
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+// ErrSqliteUnsupported is returned by NewSqliteStore in builds without cgo, which don't include the SQLite driver.
+var ErrSqliteUnsupported = errors.New("the sqlite oplog store requires a build with cgo enabled, this build doesn't include it")
+
+// OpLog is a log of operations performed. Operations are indexed by repo, plan, snapshot, time, type and status. Every change
+// is journaled as an event with an increasing seq and handed to the subscribers.
+//
+// BoltStore is the default implementation, MemStore keeps the log in memory for testing and SqliteStore keeps it in a SQLite
+// database that can be queried with SQL tools.
+type OpLog interface {
+	// Add adds the operation to the log and sets its id.
+	Add(op *v1.Operation) error
+	// BulkAdd adds the operations in a single transaction and sets their ids.
+	BulkAdd(ops []*v1.Operation) error
+	// Insert adds the operations in a single transaction with new ids derived from their start time, so that they sort into the
+	// existing history rather than after it. Used to import operations.
+	Insert(ops []*v1.Operation) error
+	// Update replaces the operation with the same id.
+	Update(op *v1.Operation) error
+	// UpdateProgress records transient progress of an in progress operation, see BoltStore.UpdateProgress.
+	UpdateProgress(op *v1.Operation) error
+	// Delete removes the operations with the given ids.
+	Delete(ids ...int64) error
+	// Get returns the operation with the id or ErrNotExist.
+	Get(id int64) (*v1.Operation, error)
+	// Query calls do for each operation matching the query ordered by id, see BoltStore.Query.
+	Query(q Query, do func(op *v1.Operation) error) (int64, error)
+	// CountByPlan returns the number of operations of each plan, operations without a plan are counted under "".
+	CountByPlan() (map[string]int, error)
+	// Scan removes operations that were pending or in progress when the log was last closed, calling onIncomplete for the
+	// ones that were in progress. Should only be called at startup.
+	Scan(onIncomplete func(op *v1.Operation)) error
+	// Subscribe returns a subscription to the log's events, see Subscription.
+	Subscribe(bufferSize int, policy OverflowPolicy) *Subscription
+	// SubscriberStats returns a snapshot of the subscribers' buffer usage and overflow counters.
+	SubscriberStats() SubscriberStats
+	// EventsSince returns the journaled events after seq and the current seq, or ErrResyncRequired.
+	EventsSince(seq int64) ([]*v1.OperationEvent, int64, error)
+	// CurrentSeq returns the seq of the most recent event, 0 if nothing was journaled yet.
+	CurrentSeq() (int64, error)
+	Close() error
+}
+
+// baseStore holds the state the OpLog implementations share: the write lock, the transient progress and the subscribers.
+type baseStore struct {
+	writeMu sync.Mutex // held across a write and its notification so that subscribers observe events in seq order.
+
+	progressMu sync.Mutex
+	progress   map[int64]*progressEntry // transient progress of in progress operations, see UpdateProgress.
+
+	subscribersMu      sync.RWMutex
+	subscribers        []*Subscription
+	subscriberCounters subscriberCounters
+}
+
+// notifyHelper hands the events to every subscriber's buffer, it never waits on a subscriber.
+func (o *baseStore) notifyHelper(events ...*v1.OperationEvent) {
+	o.subscribersMu.RLock()
+	defer o.subscribersMu.RUnlock()
+	for _, event := range events {
+		for _, sub := range o.subscribers {
+			sub.push(event)
+		}
+	}
+}
+
+// visitHelper calls do for the candidates returned by next, in iteration order, that match the query's text search. next returns
+// nil once there are no more candidates. Returns the cursor for the next page if the query's limit is reached, otherwise 0.
+func (o *baseStore) visitHelper(q *Query, next func() (*v1.Operation, error), do func(op *v1.Operation) error) (int64, error) {
+	count := 0
+	var lastId int64
+	for {
+		op, err := next()
+		if err != nil {
+			return 0, err
+		} else if op == nil {
+			return 0, nil
+		}
+		op = o.withProgressHelper(op)
+		if !q.matches(op) {
+			continue
+		}
+		if q.Limit > 0 && count == q.Limit {
+			return lastId, nil
+		}
+		count++
+		lastId = op.Id
+		if err := do(op); err != nil {
+			if err == ErrStopIteration {
+				return 0, nil
+			}
+			return 0, err
+		}
+	}
+}
+
+// newEventHelper returns the event for a change to op, the event holds a copy as subscribers read it after the caller may have changed op.
+func newEventHelper(eventType v1.OperationEventType, op *v1.Operation, seq int64) *v1.OperationEvent {
+	return &v1.OperationEvent{
+		Type:      eventType,
+		Operation: proto.Clone(op).(*v1.Operation),
+		Seq:       seq,
+	}
+}
+
+// operationId returns the id of the seq'th operation created at unixTimeMs, ids order operations by creation time.
+func operationId(unixTimeMs int64, seq uint64) int64 {
+	return int64(unixTimeMs<<20) | int64(seq&((1<<20)-1))
+}
+
+// scanHelper implements Scan for stores that index operations by status. Cancelled operations are removed unless they carry a
+// message explaining the cancellation e.g. a backup skipped by a hook, those are kept as a record of the skipped run.
+func scanHelper(log OpLog, onIncomplete func(op *v1.Operation)) error {
+	var incomplete []*v1.Operation
+	if _, err := log.Query(Query{Statuses: []v1.OperationStatus{
+		v1.OperationStatus_STATUS_PENDING,
+		v1.OperationStatus_STATUS_INPROGRESS,
+		v1.OperationStatus_STATUS_SYSTEM_CANCELLED,
+		v1.OperationStatus_STATUS_USER_CANCELLED,
+		v1.OperationStatus_STATUS_UNKNOWN,
+	}}, func(op *v1.Operation) error {
+		incomplete = append(incomplete, op)
+		return nil
+	}); err != nil {
+		return fmt.Errorf("scanning log: %w", err)
+	}
+
+	ids := make([]int64, 0, len(incomplete))
+	for _, op := range incomplete {
+		if op.Status == v1.OperationStatus_STATUS_INPROGRESS {
+			onIncomplete(op)
+		}
+		if (op.Status == v1.OperationStatus_STATUS_SYSTEM_CANCELLED || op.Status == v1.OperationStatus_STATUS_USER_CANCELLED) && op.DisplayMessage != "" {
+			continue
+		}
+		ids = append(ids, op.Id)
+	}
+	if len(ids) > 0 {
+		if err := log.Delete(ids...); err != nil {
+			return fmt.Errorf("removing incomplete operations: %w", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,20 @@
+//go:build cgo
+
+package oplog
+
+import "testing"
+
+func init() {
+	testStores["sqlite"] = func(t *testing.T) OpLog {
+		log, err := NewSqliteStore(t.TempDir() + "/test.sqlite")
+		if err != nil {
+			t.Fatalf("error creating oplog: %s", err)
+		}
+		return log
+	}
+}
+
+func (o *SqliteStore) clearPlanId(id int64) error {
+	_, err := o.db.Exec("UPDATE operations SET plan_id = '' WHERE id = ?", id)
+	return err
+}
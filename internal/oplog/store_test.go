@@ -0,0 +1,212 @@
+package oplog
+
+import (
+	"bytes"
+	"errors"
+	"maps"
+	"slices"
+	"testing"
+
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+	"github.com/garethgeorge/backrest/internal/oplog/serializationutil"
+	bolt "go.etcd.io/bbolt"
+	"google.golang.org/protobuf/proto"
+)
+
+// testStores open an empty store of each OpLog implementation available in the build.
+var testStores = map[string]func(t *testing.T) OpLog{
+	"bbolt": func(t *testing.T) OpLog {
+		log, err := NewBoltStore(t.TempDir() + "/test.boltdb")
+		if err != nil {
+			t.Fatalf("error creating oplog: %s", err)
+		}
+		return log
+	},
+	"memory": func(t *testing.T) OpLog {
+		return NewMemStore()
+	},
+}
+
+// forEachTestStore runs the test as a parallel subtest against an empty store of each implementation.
+func forEachTestStore(t *testing.T, test func(t *testing.T, log OpLog)) {
+	t.Helper()
+	var names []string
+	for name := range testStores {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	for _, name := range names {
+		open := testStores[name]
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			log := open(t)
+			t.Cleanup(func() { log.Close() })
+			test(t, log)
+		})
+	}
+}
+
+func TestStoreAddUpdateDelete(t *testing.T) {
+	t.Parallel()
+	forEachTestStore(t, func(t *testing.T, log OpLog) {
+		op := &v1.Operation{UnixTimeStartMs: 1000, PlanId: "plan1", RepoId: "repo1", SnapshotId: snapshotId, DisplayMessage: "op1", Op: &v1.Operation_OperationBackup{}}
+		if err := log.Add(op); err != nil {
+			t.Fatalf("error adding operation: %s", err)
+		}
+		if op.Id == 0 {
+			t.Fatalf("want Add to set the operation's id")
+		}
+		if err := log.Add(&v1.Operation{UnixTimeStartMs: 1000, RepoId: "repo1", Op: &v1.Operation_OperationBackup{}}); err == nil {
+			t.Errorf("want an error adding an operation without a plan")
+		}
+
+		op.DisplayMessage = "op1 updated"
+		op.PlanId = "plan2"
+		if err := log.Update(op); err != nil {
+			t.Fatalf("error updating operation: %s", err)
+		}
+		if got, err := log.Get(op.Id); err != nil || got.DisplayMessage != "op1 updated" {
+			t.Errorf("want the updated operation, got %v, err: %v", got, err)
+		}
+		if got, _ := queryMessages(t, log, Query{PlanId: "plan2", SnapshotId: snapshotId}); !slices.Equal(got, []string{"op1 updated"}) {
+			t.Errorf("want the operation indexed under its new plan, got %v", got)
+		}
+		if counts, err := log.CountByPlan(); err != nil || !maps.Equal(counts, map[string]int{"plan2": 1}) {
+			t.Errorf("want counts {plan2: 1}, got %v, err: %v", counts, err)
+		}
+
+		if err := log.Delete(op.Id); err != nil {
+			t.Fatalf("error deleting operation: %s", err)
+		}
+		if _, err := log.Get(op.Id); !errors.Is(err, ErrNotExist) {
+			t.Errorf("want ErrNotExist after delete, got %v", err)
+		}
+		if err := log.Update(op); !errors.Is(err, ErrNotExist) {
+			t.Errorf("want ErrNotExist updating a deleted operation, got %v", err)
+		}
+
+		events, seq, err := log.EventsSince(0)
+		if err != nil {
+			t.Fatalf("error getting events: %s", err)
+		}
+		var types []v1.OperationEventType
+		for _, event := range events {
+			types = append(types, event.Type)
+		}
+		if want := []v1.OperationEventType{v1.OperationEventType_EVENT_CREATED, v1.OperationEventType_EVENT_UPDATED, v1.OperationEventType_EVENT_DELETED}; seq != 3 || !slices.Equal(types, want) {
+			t.Errorf("want events %v up to seq 3, got %v up to seq %d", want, types, seq)
+		}
+	})
+}
+
+func TestStoreScan(t *testing.T) {
+	t.Parallel()
+	forEachTestStore(t, func(t *testing.T, log OpLog) {
+		for _, status := range []v1.OperationStatus{v1.OperationStatus_STATUS_SUCCESS, v1.OperationStatus_STATUS_PENDING, v1.OperationStatus_STATUS_INPROGRESS} {
+			if err := log.Add(&v1.Operation{UnixTimeStartMs: 1000, PlanId: "plan1", RepoId: "repo1", DisplayMessage: status.String(), Status: status, Op: &v1.Operation_OperationBackup{}}); err != nil {
+				t.Fatalf("error adding operation: %s", err)
+			}
+		}
+		// cancellations are removed unless they explain why the operation was cancelled.
+		for _, msg := range []string{"", "skipped"} {
+			if err := log.Add(&v1.Operation{UnixTimeStartMs: 1000, PlanId: "plan1", RepoId: "repo1", DisplayMessage: msg, Status: v1.OperationStatus_STATUS_SYSTEM_CANCELLED, Op: &v1.Operation_OperationBackup{}}); err != nil {
+				t.Fatalf("error adding operation: %s", err)
+			}
+		}
+
+		var incomplete []string
+		if err := log.Scan(func(op *v1.Operation) {
+			incomplete = append(incomplete, op.DisplayMessage)
+		}); err != nil {
+			t.Fatalf("error scanning oplog: %s", err)
+		}
+		if want := []string{"STATUS_INPROGRESS"}; !slices.Equal(incomplete, want) {
+			t.Errorf("want incomplete operations %v, got %v", want, incomplete)
+		}
+		if got, _ := queryMessages(t, log, Query{}); !slices.Equal(got, []string{"STATUS_SUCCESS", "skipped"}) {
+			t.Errorf("want only the complete and skipped operations left, got %v", got)
+		}
+	})
+}
+
+func TestStoreExportImport(t *testing.T) {
+	t.Parallel()
+	forEachTestStore(t, func(t *testing.T, log OpLog) {
+		src := NewMemStore()
+		addQueryTestOps(t, src)
+		var buf bytes.Buffer
+		if _, err := ExportTo(&buf, src, Query{}, nil); err != nil {
+			t.Fatalf("error exporting: %s", err)
+		}
+
+		if err := log.Add(&v1.Operation{UnixTimeStartMs: 2500, PlanId: "plan1", RepoId: "repo1", DisplayMessage: "existing", Op: &v1.Operation_OperationBackup{}}); err != nil {
+			t.Fatalf("error adding operation: %s", err)
+		}
+		if count, err := ImportFrom(log, &buf, nil); err != nil || count != 5 {
+			t.Fatalf("want 5 operations imported, got %d, err: %v", count, err)
+		}
+
+		// imported operations sort by their start time, the existing operation was added now so it sorts last.
+		want := []string{"op1", "op2", "op3 Failed", "op4 failed", "op5", "existing"}
+		if got, _ := queryMessages(t, log, Query{}); !slices.Equal(got, want) {
+			t.Errorf("want operations %v, got %v", want, got)
+		}
+	})
+}
+
+// clearPlanId removes the plan of an operation bypassing validation, as stored by versions that allowed operations without a plan.
+func clearPlanId(t *testing.T, log OpLog, id int64) {
+	t.Helper()
+	var err error
+	switch log := log.(type) {
+	case *BoltStore:
+		err = log.db.Update(func(tx *bolt.Tx) error {
+			op, err := log.getOperationHelper(tx.Bucket(OpLogBucket), id)
+			if err != nil {
+				return err
+			}
+			if err := log.unindexOperationHelper(tx, op); err != nil {
+				return err
+			}
+			op.PlanId = ""
+			bytes, err := proto.Marshal(op)
+			if err != nil {
+				return err
+			}
+			if err := tx.Bucket(OpLogBucket).Put(serializationutil.Itob(id), bytes); err != nil {
+				return err
+			}
+			return log.indexOperationHelper(tx, op)
+		})
+	case *MemStore:
+		log.mu.Lock()
+		log.ops[id].PlanId = ""
+		log.mu.Unlock()
+	case interface{ clearPlanId(id int64) error }: // stores only built with some build tags e.g. SqliteStore.
+		err = log.clearPlanId(id)
+	default:
+		t.Fatalf("unsupported store %T", log)
+	}
+	if err != nil {
+		t.Fatalf("error clearing plan of operation %v: %s", id, err)
+	}
+}
+
+func TestStoreCountByPlanWithoutPlan(t *testing.T) {
+	t.Parallel()
+	forEachTestStore(t, func(t *testing.T, log OpLog) {
+		var ids []int64
+		for i := 0; i < 3; i++ {
+			op := &v1.Operation{UnixTimeStartMs: int64(1000 + i), PlanId: "plan1", RepoId: "repo1", Op: &v1.Operation_OperationBackup{}}
+			if err := log.Add(op); err != nil {
+				t.Fatalf("error adding operation: %s", err)
+			}
+			ids = append(ids, op.Id)
+		}
+		clearPlanId(t, log, ids[0])
+
+		if counts, err := log.CountByPlan(); err != nil || !maps.Equal(counts, map[string]int{"plan1": 2, "": 1}) {
+			t.Errorf("want counts {plan1: 2, \"\": 1}, got %v, err: %v", counts, err)
+		}
+	})
+}
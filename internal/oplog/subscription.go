@@ -0,0 +1,226 @@
+package oplog
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+	"go.uber.org/zap"
+)
+
+// ErrSubscriberOverflow is returned by Subscription.Next after a subscription with OverflowDisconnect fell too far behind.
+var ErrSubscriberOverflow = errors.New("subscriber fell behind and was disconnected")
+
+// ErrSubscriptionClosed is returned by Subscription.Next after the subscription is closed.
+var ErrSubscriptionClosed = errors.New("subscription closed")
+
+// OverflowPolicy decides what happens when a subscriber's buffer is full.
+type OverflowPolicy int
+
+const (
+	// OverflowResync drops the buffered events and queues a single EVENT_RESYNC_REQUIRED event in their place.
+	OverflowResync OverflowPolicy = iota
+	// OverflowDisconnect closes the subscription with ErrSubscriberOverflow.
+	OverflowDisconnect
+)
+
+// SubscriberStats describes the subscribers of an OpLog, counters are totals since the OpLog was opened.
+type SubscriberStats struct {
+	Subscribers   int   // number of open subscriptions.
+	Lagging       int   // subscriptions whose buffer is at least half full.
+	Buffered      int   // events buffered across all subscriptions.
+	DroppedEvents int64 // events dropped because a buffer overflowed.
+	Resyncs       int64 // overflows handled with OverflowResync.
+	Disconnects   int64 // overflows handled with OverflowDisconnect.
+}
+
+type subscriberCounters struct {
+	droppedEvents atomic.Int64
+	resyncs       atomic.Int64
+	disconnects   atomic.Int64
+}
+
+// Subscription receives the changes to the log in seq order. Events are buffered so that a slow subscriber never blocks writers.
+type Subscription struct {
+	o      *baseStore
+	size   int
+	policy OverflowPolicy
+
+	mu     sync.Mutex
+	buf    []*v1.OperationEvent
+	err    error         // set once the subscription is closed.
+	notify chan struct{} // signalled when an event is buffered or the subscription is closed.
+}
+
+// Subscribe returns a subscription buffering up to bufferSize events, policy decides what happens once the buffer is full.
+// The subscription must be closed when no longer needed.
+func (o *baseStore) Subscribe(bufferSize int, policy OverflowPolicy) *Subscription {
+	if bufferSize < 1 {
+		bufferSize = 1
+	}
+	sub := &Subscription{
+		o:      o,
+		size:   bufferSize,
+		policy: policy,
+		notify: make(chan struct{}, 1),
+	}
+
+	o.subscribersMu.Lock()
+	defer o.subscribersMu.Unlock()
+	o.subscribers = append(o.subscribers, sub)
+	return sub
+}
+
+// SubscriberStats returns a snapshot of the subscribers' buffer usage and overflow counters.
+func (o *baseStore) SubscriberStats() SubscriberStats {
+	stats := SubscriberStats{
+		DroppedEvents: o.subscriberCounters.droppedEvents.Load(),
+		Resyncs:       o.subscriberCounters.resyncs.Load(),
+		Disconnects:   o.subscriberCounters.disconnects.Load(),
+	}
+
+	o.subscribersMu.RLock()
+	defer o.subscribersMu.RUnlock()
+	for _, sub := range o.subscribers {
+		sub.mu.Lock()
+		buffered := len(sub.buf)
+		sub.mu.Unlock()
+
+		stats.Subscribers++
+		stats.Buffered += buffered
+		if buffered*2 >= sub.size {
+			stats.Lagging++
+		}
+	}
+	return stats
+}
+
+// ReportSubscriberStats logs the subscriber stats of the log every interval until the context is done. Reports are warnings if
+// events were dropped since the previous report or a subscriber is lagging.
+func ReportSubscriberStats(ctx context.Context, log OpLog, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	var prev SubscriberStats
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		stats := log.SubscriberStats()
+		reportSubscriberStats(prev, stats)
+		prev = stats
+	}
+}
+
+func reportSubscriberStats(prev, stats SubscriberStats) {
+	fields := []zap.Field{
+		zap.Int("subscribers", stats.Subscribers),
+		zap.Int("lagging", stats.Lagging),
+		zap.Int("buffered", stats.Buffered),
+		zap.Int64("dropped_events", stats.DroppedEvents-prev.DroppedEvents),
+		zap.Int64("resyncs", stats.Resyncs-prev.Resyncs),
+		zap.Int64("disconnects", stats.Disconnects-prev.Disconnects),
+	}
+	if stats.DroppedEvents > prev.DroppedEvents || stats.Lagging > 0 {
+		zap.L().Warn("oplog subscribers are falling behind", fields...)
+	} else {
+		zap.L().Debug("oplog subscriber stats", fields...)
+	}
+}
+
+// Next blocks until an event is available, the context is done or the subscription is closed.
+func (s *Subscription) Next(ctx context.Context) (*v1.OperationEvent, error) {
+	for {
+		s.mu.Lock()
+		if s.err != nil {
+			err := s.err
+			s.mu.Unlock()
+			return nil, err
+		}
+		if len(s.buf) > 0 {
+			event := s.buf[0]
+			s.buf[0] = nil
+			s.buf = s.buf[1:]
+			s.mu.Unlock()
+			return event, nil
+		}
+		s.mu.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-s.notify:
+		}
+	}
+}
+
+// Close removes the subscription from the log, buffered events are discarded.
+func (s *Subscription) Close() {
+	s.o.subscribersMu.Lock()
+	subs := s.o.subscribers
+	for i, sub := range subs {
+		if sub == s {
+			subs[i] = subs[len(subs)-1]
+			subs[len(subs)-1] = nil
+			s.o.subscribers = subs[:len(subs)-1]
+			break
+		}
+	}
+	s.o.subscribersMu.Unlock()
+
+	s.closeHelper(ErrSubscriptionClosed)
+}
+
+func (s *Subscription) closeHelper(err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.err == nil {
+		s.err = err
+		s.buf = nil
+	}
+	s.signalHelper()
+}
+
+func (s *Subscription) signalHelper() {
+	select {
+	case s.notify <- struct{}{}:
+	default:
+	}
+}
+
+// push buffers the event without blocking, applying the overflow policy if the buffer is full.
+func (s *Subscription) push(event *v1.OperationEvent) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.err != nil {
+		return
+	}
+	defer s.signalHelper()
+
+	if len(s.buf) < s.size {
+		s.buf = append(s.buf, event)
+		return
+	}
+
+	counters := &s.o.subscriberCounters
+	counters.droppedEvents.Add(int64(len(s.buf)) + 1)
+	switch s.policy {
+	case OverflowDisconnect:
+		counters.disconnects.Add(1)
+		zap.L().Warn("oplog subscriber fell behind, disconnecting it", zap.Int("buffer_size", s.size), zap.Int64("seq", event.Seq))
+		s.err = ErrSubscriberOverflow
+		s.buf = nil
+	default:
+		counters.resyncs.Add(1)
+		zap.L().Warn("oplog subscriber fell behind, dropping its buffered events for a resync", zap.Int("buffer_size", s.size), zap.Int64("seq", event.Seq))
+		s.buf = append(s.buf[:0], &v1.OperationEvent{
+			Type: v1.OperationEventType_EVENT_RESYNC_REQUIRED,
+			Seq:  event.Seq,
+		})
+	}
+}
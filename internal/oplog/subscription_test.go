@@ -0,0 +1,114 @@
+package oplog
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func addSubscriptionTestOps(t *testing.T, log *BoltStore, n int) {
+	t.Helper()
+	for i := 0; i < n; i++ {
+		if err := log.Add(&v1.Operation{UnixTimeStartMs: int64(i + 1), PlanId: "plan1", RepoId: "repo1", Status: v1.OperationStatus_STATUS_SUCCESS, Op: &v1.Operation_OperationBackup{}}); err != nil {
+			t.Fatalf("error adding operation: %s", err)
+		}
+	}
+}
+
+func TestSubscriptionOverflow(t *testing.T) {
+	t.Parallel()
+	log, err := NewBoltStore(t.TempDir() + "/test.boltdb")
+	if err != nil {
+		t.Fatalf("error creating oplog: %s", err)
+	}
+	t.Cleanup(func() { log.Close() })
+
+	resync := log.Subscribe(2, OverflowResync)
+	defer resync.Close()
+	disconnect := log.Subscribe(2, OverflowDisconnect)
+	defer disconnect.Close()
+
+	// writes complete even though nobody reads the subscriptions.
+	addSubscriptionTestOps(t, log, 3)
+
+	if stats := log.SubscriberStats(); stats.Subscribers != 2 || stats.Lagging != 1 || stats.Resyncs != 1 || stats.Disconnects != 1 || stats.DroppedEvents != 6 {
+		t.Errorf("unexpected subscriber stats: %+v", stats)
+	}
+
+	event, err := resync.Next(context.Background())
+	if err != nil {
+		t.Fatalf("error receiving event: %s", err)
+	}
+	if event.Type != v1.OperationEventType_EVENT_RESYNC_REQUIRED || event.Seq != 3 {
+		t.Errorf("want resync event at seq 3, got %v", event)
+	}
+	addSubscriptionTestOps(t, log, 1)
+	if event, err := resync.Next(context.Background()); err != nil || event.Seq != 4 {
+		t.Errorf("want event at seq 4 after the resync, got %v, err: %v", event, err)
+	}
+
+	if _, err := disconnect.Next(context.Background()); !errors.Is(err, ErrSubscriberOverflow) {
+		t.Errorf("want ErrSubscriberOverflow, got %v", err)
+	}
+}
+
+func TestSubscriptionNextBlocks(t *testing.T) {
+	t.Parallel()
+	log, err := NewBoltStore(t.TempDir() + "/test.boltdb")
+	if err != nil {
+		t.Fatalf("error creating oplog: %s", err)
+	}
+	t.Cleanup(func() { log.Close() })
+
+	sub := log.Subscribe(10, OverflowDisconnect)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := sub.Next(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("want context.DeadlineExceeded, got %v", err)
+	}
+
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		log.Add(&v1.Operation{UnixTimeStartMs: 1, PlanId: "plan1", RepoId: "repo1", Status: v1.OperationStatus_STATUS_SUCCESS, Op: &v1.Operation_OperationBackup{}})
+	}()
+	if event, err := sub.Next(context.Background()); err != nil || event.Type != v1.OperationEventType_EVENT_CREATED {
+		t.Errorf("want created event, got %v, err: %v", event, err)
+	}
+
+	sub.Close()
+	if _, err := sub.Next(context.Background()); !errors.Is(err, ErrSubscriptionClosed) {
+		t.Errorf("want ErrSubscriptionClosed, got %v", err)
+	}
+	if stats := log.SubscriberStats(); stats.Subscribers != 0 {
+		t.Errorf("want no subscribers after close, got %+v", stats)
+	}
+}
+
+func TestReportSubscriberStats(t *testing.T) {
+	core, logs := observer.New(zapcore.DebugLevel)
+	defer zap.ReplaceGlobals(zap.New(core))()
+
+	prev := SubscriberStats{Subscribers: 2, DroppedEvents: 4, Resyncs: 2}
+	reportSubscriberStats(prev, prev)
+	reportSubscriberStats(prev, SubscriberStats{Subscribers: 2, Lagging: 1, Buffered: 8, DroppedEvents: 10, Resyncs: 3})
+
+	entries := logs.All()
+	if len(entries) != 2 {
+		t.Fatalf("want 2 reports, got %d", len(entries))
+	}
+	if entries[0].Level != zapcore.DebugLevel {
+		t.Errorf("want a debug report without overflows, got %v", entries[0].Level)
+	}
+	if entries[1].Level != zapcore.WarnLevel {
+		t.Errorf("want a warning after overflows, got %v", entries[1].Level)
+	}
+	if fields := entries[1].ContextMap(); fields["dropped_events"] != int64(6) || fields["resyncs"] != int64(1) || fields["lagging"] != int64(1) {
+		t.Errorf("unexpected report fields: %v", fields)
+	}
+}
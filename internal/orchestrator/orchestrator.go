@@ -24,6 +24,9 @@ var ErrPlanNotFound = errors.New("plan not found")
 
 const PlanForUnassociatedOperations = "_unassociated_"
 
+// asyncHookShutdownTimeout bounds how long shutdown waits for async hooks, hooks without a timeout could otherwise block it.
+var asyncHookShutdownTimeout = 30 * time.Second
+
 const (
 	TaskPriorityDefault        = 0
 	TaskPriorityInteractive    = 10
@@ -38,7 +41,7 @@ const (
 type Orchestrator struct {
 	mu           sync.Mutex
 	config       *v1.Config
-	OpLog        *oplog.OpLog
+	OpLog        oplog.OpLog
 	repoPool     *resticRepoPool
 	taskQueue    taskQueue
 	hookExecutor *hook.HookExecutor
@@ -48,9 +51,12 @@ type Orchestrator struct {
 	now func() time.Time
 
 	runningTask atomic.Pointer[taskExecutionInfo]
+
+	gcMu      sync.Mutex // serializes garbage collection runs.
+	gcLastRun *gcRun     // the last completed garbage collection run, guarded by gcMu.
 }
 
-func NewOrchestrator(resticBin string, cfg *v1.Config, oplog *oplog.OpLog, logStore *rotatinglog.RotatingLog) (*Orchestrator, error) {
+func NewOrchestrator(resticBin string, cfg *v1.Config, oplog oplog.OpLog, logStore *rotatinglog.RotatingLog) (*Orchestrator, error) {
 	cfg = proto.Clone(cfg).(*v1.Config)
 
 	// create the orchestrator.
@@ -115,6 +121,7 @@ func (o *Orchestrator) ApplyConfig(cfg *v1.Config) error {
 	o.mu.Lock()
 	defer o.mu.Unlock()
 	o.config = cfg
+	o.hookExecutor.SetGlobalHooks(cfg.Hooks)
 
 	// Update the config provided to the repo pool.
 	if err := o.repoPool.configProvider.Update(cfg); err != nil {
@@ -147,6 +154,13 @@ func (o *Orchestrator) ApplyConfig(cfg *v1.Config) error {
 		}
 		o.ScheduleTask(t, TaskPriorityDefault)
 	}
+	if cfg.GetSelfBackup().GetRepo() != "" {
+		t, err := NewScheduledSelfBackupTask(o, cfg.SelfBackup, config.DataDir(), config.ConfigFilePath())
+		if err != nil {
+			return fmt.Errorf("schedule self backup task: %w", err)
+		}
+		o.ScheduleTask(t, TaskPriorityDefault)
+	}
 
 	return nil
 }
@@ -179,13 +193,61 @@ func (o *Orchestrator) GetPlan(planId string) (*v1.Plan, error) {
 	return nil, ErrPlanNotFound
 }
 
+// TestHook runs the hook once for the condition with variables sampled from the plan's or the repo's recent operations. The run
+// is not recorded in the oplog, failures of the hook are reported in the response rather than returned.
+func (o *Orchestrator) TestHook(ctx context.Context, req *v1.TestHookRequest) (*v1.TestHookResponse, error) {
+	if req.Hook == nil {
+		return nil, errors.New("hook is required")
+	}
+
+	var plan *v1.Plan
+	repoId := req.RepoId
+	if req.PlanId != "" {
+		var err error
+		plan, err = o.GetPlan(req.PlanId)
+		if err != nil {
+			return nil, fmt.Errorf("get plan %q: %w", req.PlanId, err)
+		}
+		if repoId == "" {
+			repoId = plan.Repo
+		}
+	}
+	if repoId == "" {
+		return nil, errors.New("plan_id or repo_id is required")
+	}
+	repo, err := o.GetRepo(repoId)
+	if err != nil {
+		return nil, err
+	}
+
+	condition := req.Condition
+	if condition == v1.Hook_CONDITION_UNKNOWN {
+		if len(req.Hook.Conditions) == 0 {
+			return nil, errors.New("condition is required for a hook without conditions")
+		}
+		condition = req.Hook.Conditions[0]
+	}
+
+	vars := o.hookExecutor.SampleVars(repo.Config(), plan, condition)
+	rendered, output, err := o.hookExecutor.TestHook(ctx, req.Hook, condition, vars)
+	resp := &v1.TestHookResponse{
+		Condition: condition,
+		Rendered:  rendered,
+		Success:   err == nil,
+		Output:    output,
+	}
+	if err != nil {
+		resp.Error = err.Error()
+	}
+	return resp, nil
+}
+
 func (o *Orchestrator) CancelOperation(operationId int64, status v1.OperationStatus) error {
 	o.mu.Lock()
 	defer o.mu.Unlock()
 
-	// note: if the task is running the requested status will not be set.
 	if running := o.runningTask.Load(); running != nil && running.operationId == operationId {
-		running.cancel()
+		running.cancel(&taskCancelledError{status: status})
 	}
 
 	tasks := o.taskQueue.Reset()
@@ -231,7 +293,7 @@ func (o *Orchestrator) Run(mainCtx context.Context) {
 
 		zap.L().Info("running task", zap.String("task", t.task.Name()))
 
-		taskCtx, cancel := context.WithCancel(mainCtx)
+		taskCtx, cancel := context.WithCancelCause(mainCtx)
 
 		if swapped := o.runningTask.CompareAndSwap(nil, &taskExecutionInfo{
 			operationId: t.task.OperationId(),
@@ -248,6 +310,7 @@ func (o *Orchestrator) Run(mainCtx context.Context) {
 			zap.L().Info("task finished", zap.String("task", t.task.Name()), zap.Duration("duration", time.Since(start)))
 		}
 		o.runningTask.Store(nil)
+		cancel(nil)
 
 		for _, cb := range t.callbacks {
 			cb(err)
@@ -255,6 +318,13 @@ func (o *Orchestrator) Run(mainCtx context.Context) {
 
 		o.ScheduleTask(t.task, t.priority)
 	}
+
+	// async hooks record their results in the oplog, give them a chance to complete before it's closed.
+	waitCtx, cancel := context.WithTimeout(context.Background(), asyncHookShutdownTimeout)
+	defer cancel()
+	if err := o.hookExecutor.Wait(waitCtx); err != nil {
+		zap.L().Warn("async hooks still running at shutdown", zap.Error(err))
+	}
 }
 
 func (o *Orchestrator) ScheduleTask(t Task, priority int, callbacks ...func(error)) {
@@ -329,5 +399,5 @@ func (rp *resticRepoPool) GetRepo(repoId string) (repo *RepoOrchestrator, err er
 
 type taskExecutionInfo struct {
 	operationId int64
-	cancel      func()
+	cancel      context.CancelCauseFunc
 }
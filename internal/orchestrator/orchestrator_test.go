@@ -2,12 +2,17 @@ package orchestrator
 
 import (
 	"context"
+	"os"
+	"path/filepath"
+	"runtime"
 	"sync"
 	"testing"
 	"time"
 
 	v1 "github.com/garethgeorge/backrest/gen/go/v1"
 	"github.com/garethgeorge/backrest/internal/config"
+	"github.com/garethgeorge/backrest/internal/oplog"
+	"github.com/garethgeorge/backrest/internal/rotatinglog"
 )
 
 type testTask struct {
@@ -200,3 +205,37 @@ func TestSchedulerWait(t *testing.T) {
 	case <-ran:
 	}
 }
+
+// fakeResticBinary returns the path of a shell script run in place of restic, script is the body of the script.
+func fakeResticBinary(t *testing.T, script string) string {
+	t.Helper()
+	if runtime.GOOS == "windows" {
+		t.Skip("the fake restic binary is a shell script")
+	}
+	bin := filepath.Join(t.TempDir(), "restic")
+	if err := os.WriteFile(bin, []byte("#!/bin/sh\n"+script+"\n"), 0755); err != nil {
+		t.Fatalf("failed to write fake restic binary: %v", err)
+	}
+	return bin
+}
+
+// newTestOrchestrator returns an orchestrator for cfg that runs script in place of restic, see fakeResticBinary. Operations are
+// recorded in log.
+func newTestOrchestrator(t *testing.T, script string, cfg *v1.Config, log oplog.OpLog) *Orchestrator {
+	t.Helper()
+	orch, err := NewOrchestrator(fakeResticBinary(t, script), cfg, log, rotatinglog.NewRotatingLog(t.TempDir(), 10))
+	if err != nil {
+		t.Fatalf("failed to create orchestrator: %v", err)
+	}
+	return orch
+}
+
+// runTestTask runs the task returned by newTask once with an orchestrator from newTestOrchestrator, returning the task's error.
+func runTestTask(t *testing.T, script string, cfg *v1.Config, log oplog.OpLog, newTask func(orch *Orchestrator) Task) error {
+	t.Helper()
+	task := newTask(newTestOrchestrator(t, script, cfg, log))
+	if task.Next(time.Now()) == nil {
+		t.Fatalf("expected task %v to be scheduled", task.Name())
+	}
+	return task.Run(context.Background())
+}
@@ -0,0 +1,16 @@
+//go:build windows
+// +build windows
+
+package orchestrator
+
+import "os"
+
+// processAlive returns true if a process with the given pid is running on this host.
+func processAlive(pid int) bool {
+	p, err := os.FindProcess(pid) // opens a handle to the process on windows, fails if it doesn't exist.
+	if err != nil {
+		return false
+	}
+	p.Release()
+	return true
+}
@@ -2,8 +2,12 @@ package orchestrator
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
 	"slices"
 	"sort"
 	"strings"
@@ -13,6 +17,7 @@ import (
 	v1 "github.com/garethgeorge/backrest/gen/go/v1"
 	"github.com/garethgeorge/backrest/internal/protoutil"
 	"github.com/garethgeorge/backrest/pkg/restic"
+	"github.com/garethgeorge/backrest/pkg/resticrepo"
 	"github.com/google/shlex"
 	"go.uber.org/zap"
 	"google.golang.org/protobuf/proto"
@@ -26,6 +31,10 @@ type RepoOrchestrator struct {
 	repoConfig  *v1.Repo
 	repo        *restic.Repo
 	initialized bool
+
+	localRepoMu  sync.Mutex
+	localRepo    *resticrepo.Repo // reads local repos natively, opened on first use.
+	localRepoErr error            // why the repo couldn't be opened natively, it isn't retried until the config changes.
 }
 
 // NewRepoOrchestrator accepts a config and a repo that is configured with the properties of that config object.
@@ -134,7 +143,11 @@ func (r *RepoOrchestrator) Backup(ctx context.Context, plan *v1.Plan, progressCa
 		opts = append(opts, restic.WithFlags(args...))
 	}
 
-	summary, err := r.repo.Backup(ctx, plan.Paths, progressCallback, opts...)
+	var summary *restic.BackupProgressEntry
+	err = r.retryIfLocked(ctx, func() (err error) {
+		summary, err = r.repo.Backup(ctx, plan.Paths, progressCallback, opts...)
+		return err
+	})
 	if err != nil {
 		return summary, fmt.Errorf("failed to backup: %w", err)
 	}
@@ -144,6 +157,19 @@ func (r *RepoOrchestrator) Backup(ctx context.Context, plan *v1.Plan, progressCa
 }
 
 func (r *RepoOrchestrator) ListSnapshotFiles(ctx context.Context, snapshotId string, path string) ([]*v1.LsEntry, error) {
+	if localRepo := r.openLocalRepo(ctx); localRepo != nil {
+		dir, nodes, err := localRepo.ListDirectory(ctx, snapshotId, path)
+		if err == nil {
+			lsEnts := make([]*v1.LsEntry, 0, len(nodes)+1)
+			lsEnts = append(lsEnts, protoutil.NodeToProto(dir))
+			for _, node := range nodes {
+				lsEnts = append(lsEnts, protoutil.NodeToProto(node))
+			}
+			return lsEnts, nil
+		}
+		r.l.Debug("Native listing failed, falling back to restic ls", zap.String("snapshot", snapshotId), zap.Error(err))
+	}
+
 	_, entries, err := r.repo.ListDirectory(ctx, snapshotId, path)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list snapshot files: %w", err)
@@ -166,9 +192,13 @@ func (r *RepoOrchestrator) Forget(ctx context.Context, plan *v1.Plan) ([]*v1.Res
 		return nil, fmt.Errorf("plan %q has no retention policy", plan.Id)
 	}
 
-	result, err := r.repo.Forget(
-		ctx, protoutil.RetentionPolicyFromProto(plan.Retention),
-		restic.WithFlags("--tag", tagForPlan(plan)), restic.WithFlags("--group-by", "tag"))
+	var result *restic.ForgetResult
+	err := r.retryIfLocked(ctx, func() (err error) {
+		result, err = r.repo.Forget(
+			ctx, protoutil.RetentionPolicyFromProto(plan.Retention),
+			restic.WithFlags("--tag", tagForPlan(plan)), restic.WithFlags("--group-by", "tag"))
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("get snapshots for repo %v: %w", r.repoConfig.Id, err)
 	}
@@ -195,16 +225,51 @@ func (r *RepoOrchestrator) ForgetSnapshot(ctx context.Context, snapshotId string
 	return r.repo.ForgetSnapshot(ctx, snapshotId)
 }
 
-func (r *RepoOrchestrator) Prune(ctx context.Context, output io.Writer) error {
+func (r *RepoOrchestrator) Prune(ctx context.Context, output io.Writer) (*restic.PruneStats, error) {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
-	policy := r.repoConfig.PrunePolicy
-	if policy == nil {
-		policy = &v1.PrunePolicy{
+	r.l.Debug("Prune snapshots")
+	var stats *restic.PruneStats
+	err := r.retryIfLocked(ctx, func() (err error) {
+		stats, err = r.repo.Prune(ctx, output, r.pruneOpts()...)
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("prune snapshots for repo %v: %w", r.repoConfig.Id, err)
+	}
+	return stats, nil
+}
+
+// PruneDryRun measures the unused space in the repo and what a prune would remove without modifying the repo.
+func (r *RepoOrchestrator) PruneDryRun(ctx context.Context) (*restic.PruneStats, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.l.Debug("Prune snapshots (dry run)")
+	var stats *restic.PruneStats
+	err := r.retryIfLocked(ctx, func() (err error) {
+		stats, err = r.repo.PruneDryRun(ctx, r.pruneOpts()...)
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("prune dry run for repo %v: %w", r.repoConfig.Id, err)
+	}
+	return stats, nil
+}
+
+// PrunePolicy returns the repo's prune policy or the default policy if none is configured.
+func (r *RepoOrchestrator) PrunePolicy() *v1.PrunePolicy {
+	if r.repoConfig.PrunePolicy == nil {
+		return &v1.PrunePolicy{
 			MaxUnusedPercent: 25,
 		}
 	}
+	return r.repoConfig.PrunePolicy
+}
+
+func (r *RepoOrchestrator) pruneOpts() []restic.GenericOption {
+	policy := r.PrunePolicy()
 
 	var opts []restic.GenericOption
 	if policy.MaxUnusedBytes != 0 {
@@ -212,13 +277,7 @@ func (r *RepoOrchestrator) Prune(ctx context.Context, output io.Writer) error {
 	} else if policy.MaxUnusedPercent != 0 {
 		opts = append(opts, restic.WithFlags("--max-unused", fmt.Sprintf("%v%%", policy.MaxUnusedPercent)))
 	}
-
-	r.l.Debug("Prune snapshots")
-	err := r.repo.Prune(ctx, output, opts...)
-	if err != nil {
-		return fmt.Errorf("prune snapshots for repo %v: %w", r.repoConfig.Id, err)
-	}
-	return nil
+	return opts
 }
 
 func (r *RepoOrchestrator) Restore(ctx context.Context, snapshotId string, path string, target string, progressCallback func(event *v1.RestoreProgressEntry)) (*v1.RestoreProgressEntry, error) {
@@ -227,6 +286,18 @@ func (r *RepoOrchestrator) Restore(ctx context.Context, snapshotId string, path
 
 	r.l.Debug("Restore snapshot", zap.String("snapshot", snapshotId), zap.String("target", target))
 
+	// restic silently restores nothing if the included path doesn't exist, fail early instead when it can be checked cheaply. Snapshot
+	// ids the native reader can't resolve e.g. "latest" are left for restic to resolve and aren't checked.
+	if strings.HasPrefix(path, "/") && !strings.ContainsAny(path, "*?[") {
+		if localRepo := r.openLocalRepo(ctx); localRepo != nil {
+			if snapshot, err := localRepo.Snapshot(ctx, snapshotId); err == nil {
+				if _, err := localRepo.Stat(ctx, snapshot.ID, path); errors.Is(err, resticrepo.ErrNotFound) {
+					return nil, fmt.Errorf("restore snapshot %q for repo %v: path %q: %w", snapshotId, r.repoConfig.Id, path, err)
+				}
+			}
+		}
+	}
+
 	var opts []restic.GenericOption
 	opts = append(opts, restic.WithFlags("--target", target))
 	if path != "" {
@@ -245,18 +316,104 @@ func (r *RepoOrchestrator) Restore(ctx context.Context, snapshotId string, path
 	return protoutil.RestoreProgressEntryToProto(summary), nil
 }
 
-// UnlockIfAutoEnabled unlocks the repo if the auto unlock feature is enabled.
-func (r *RepoOrchestrator) UnlockIfAutoEnabled(ctx context.Context) error {
-	if !r.repoConfig.AutoUnlock {
-		return nil
+// retryIfLocked runs do and, if it fails because the repo is locked and auto unlock is enabled, removes the repo's stale locks
+// and runs do once more. Other failures e.g. a wrong password or a missing repo would fail the same way again and aren't retried.
+// The caller must hold r.mu.
+func (r *RepoOrchestrator) retryIfLocked(ctx context.Context, do func() error) error {
+	err := do()
+	if !r.repoConfig.AutoUnlock || !errors.Is(err, restic.ErrRepoLocked) {
+		return err
 	}
 
-	r.mu.Lock()
-	defer r.mu.Unlock()
+	removed, unlockErr := r.removeStaleLocks(ctx)
+	if unlockErr != nil {
+		return errors.Join(err, fmt.Errorf("auto unlock: %w", unlockErr))
+	}
+	if !removed {
+		return err
+	}
+	r.l.Info("Retrying after removing stale locks")
+	return do()
+}
 
-	zap.L().Debug("AutoUnlocking repo", zap.String("repo", r.repoConfig.Id))
+// Locks lists the locks held on the repo. It doesn't wait for running operations so that the holder of a lock can be inspected while it's held.
+func (r *RepoOrchestrator) Locks(ctx context.Context) ([]*v1.ResticLock, error) {
+	locks, err := r.repo.ListLocks(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list locks for repo %v: %w", r.repoConfig.Id, err)
+	}
 
-	return r.repo.Unlock(ctx)
+	hostname, _ := os.Hostname()
+	now := time.Now()
+	protos := make([]*v1.ResticLock, 0, len(locks))
+	for _, lock := range locks {
+		p := protoutil.LockToProto(lock)
+		p.Stale = isStaleLock(lock, r.staleLockAge(), hostname, now)
+		protos = append(protos, p)
+	}
+	return protos, nil
+}
+
+// removeStaleLocks removes the repo's stale locks and returns true if any were removed. Locks that restic itself considers stale
+// are removed by `restic unlock`, which checks each lock again as it removes it. Locks that are only stale by the repo's stale
+// lock age are removed individually by id, which is only possible for repos on the local filesystem. Locks held by live
+// processes are never removed.
+func (r *RepoOrchestrator) removeStaleLocks(ctx context.Context) (bool, error) {
+	locks, err := r.repo.ListLocks(ctx)
+	if err != nil {
+		return false, fmt.Errorf("list locks: %w", err)
+	}
+
+	hostname, _ := os.Hostname()
+	now := time.Now()
+	resticStale := false
+	var ids []string
+	for _, lock := range locks {
+		if !isStaleLock(lock, r.staleLockAge(), hostname, now) {
+			r.l.Warn("Repo is locked by a live process, not removing its lock",
+				zap.String("lock", lock.Id), zap.String("hostname", lock.Hostname), zap.Int("pid", lock.Pid), zap.Duration("age", lock.Age(now)))
+			continue
+		}
+		if lock.Age(now) > resticStaleLockAge || heldByDeadLocalProcess(lock, hostname) {
+			resticStale = true
+		} else {
+			ids = append(ids, lock.Id)
+		}
+	}
+
+	removed := false
+	if resticStale {
+		r.l.Info("Removing stale locks")
+		if err := r.repo.Unlock(ctx); err != nil {
+			return false, err
+		}
+		removed = true
+	}
+
+	if len(ids) == 0 {
+		return removed, nil
+	}
+	path, ok := localRepoPath(r.repoConfig.GetUri())
+	if !ok {
+		r.l.Warn("Not removing locks younger than restic's stale lock age, they can only be removed from repos on the local filesystem", zap.Strings("locks", ids))
+		return removed, nil
+	}
+	r.l.Info("Removing stale locks by id", zap.Strings("locks", ids))
+	for _, id := range ids {
+		// a lock's id is the hash of its contents, a live process refreshing its lock writes a new file rather than updating this one.
+		if err := os.Remove(filepath.Join(path, "locks", id)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return removed, fmt.Errorf("remove lock %v: %w", id, err)
+		}
+		removed = true
+	}
+	return removed, nil
+}
+
+func (r *RepoOrchestrator) staleLockAge() time.Duration {
+	if r.repoConfig.StaleLockAgeMinutes > 0 {
+		return time.Duration(r.repoConfig.StaleLockAgeMinutes) * time.Minute
+	}
+	return resticStaleLockAge
 }
 
 func (r *RepoOrchestrator) Unlock(ctx context.Context) error {
@@ -282,6 +439,60 @@ func (r *RepoOrchestrator) Stats(ctx context.Context) (*v1.RepoStats, error) {
 	return protoutil.RepoStatsToProto(stats), nil
 }
 
+// openLocalRepo returns a native reader for the repo or nil if the repo isn't stored on a local path or can't be opened.
+// Reading the repo natively avoids running restic, which decrypts the whole index on every invocation. A failure to open the
+// repo is remembered so that callers fall back to restic without deriving the key again, the RepoOrchestrator is replaced when
+// the repo's config changes.
+func (r *RepoOrchestrator) openLocalRepo(ctx context.Context) *resticrepo.Repo {
+	r.localRepoMu.Lock()
+	defer r.localRepoMu.Unlock()
+
+	if r.localRepo != nil || r.localRepoErr != nil {
+		return r.localRepo
+	}
+
+	path, ok := localRepoPath(r.repoConfig.GetUri())
+	if !ok {
+		return nil
+	}
+	password, ok := repoPassword(r.repoConfig)
+	if !ok {
+		return nil
+	}
+
+	localRepo, err := resticrepo.Open(ctx, path, password)
+	if err != nil {
+		r.l.Debug("Failed to open repo natively, falling back to restic", zap.Error(err))
+		if ctx.Err() == nil {
+			r.localRepoErr = err
+		}
+		return nil
+	}
+	r.localRepo = localRepo
+	return localRepo
+}
+
+// localRepoPath returns the path of a repo stored on the local filesystem e.g. "/mnt/backups" or "local:/mnt/backups".
+func localRepoPath(uri string) (string, bool) {
+	if p, ok := strings.CutPrefix(uri, "local:"); ok {
+		return p, true
+	}
+	return uri, filepath.IsAbs(uri)
+}
+
+// repoPassword returns the repo's password if it's set directly rather than by a password file or command.
+func repoPassword(repoConfig *v1.Repo) (string, bool) {
+	if p := repoConfig.GetPassword(); p != "" {
+		return p, true
+	}
+	for _, env := range repoConfig.GetEnv() {
+		if p, ok := strings.CutPrefix(env, "RESTIC_PASSWORD="); ok {
+			return p, true
+		}
+	}
+	return "", false
+}
+
 func (r *RepoOrchestrator) Config() *v1.Repo {
 	if r == nil {
 		return nil
@@ -298,3 +509,15 @@ func sortSnapshotsByTime(snapshots []*restic.Snapshot) {
 		return snapshots[i].UnixTimeMs() < snapshots[j].UnixTimeMs()
 	})
 }
+
+// resticStaleLockAge is the age after which restic considers a lock stale, locks held by a running restic are refreshed every 5 minutes.
+const resticStaleLockAge = 30 * time.Minute
+
+// isStaleLock returns true if the lock is older than maxAge or if it was created on this host by a process that is no longer running.
+func isStaleLock(lock *restic.Lock, maxAge time.Duration, hostname string, now time.Time) bool {
+	return lock.Age(now) > maxAge || heldByDeadLocalProcess(lock, hostname)
+}
+
+func heldByDeadLocalProcess(lock *restic.Lock, hostname string) bool {
+	return hostname != "" && lock.Hostname == hostname && !processAlive(lock.Pid)
+}
@@ -2,10 +2,16 @@ package orchestrator
 
 import (
 	"context"
+	"errors"
+	"os"
+	"os/exec"
+	"path/filepath"
 	"slices"
 	"testing"
+	"time"
 
 	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+	"github.com/garethgeorge/backrest/pkg/restic"
 	"github.com/garethgeorge/backrest/test/helpers"
 	test "github.com/garethgeorge/backrest/test/helpers"
 )
@@ -136,3 +142,137 @@ func TestSnapshotParenting(t *testing.T) {
 		t.Errorf("expected 8 snapshots, got %d", len(snapshots))
 	}
 }
+
+func TestIsStaleLock(t *testing.T) {
+	t.Parallel()
+
+	// a process that has exited, its pid is very unlikely to be reused while the test runs.
+	exited := exec.Command(os.Args[0], "-test.run=^$")
+	if err := exited.Run(); err != nil {
+		t.Fatalf("failed to run process: %v", err)
+	}
+
+	now := time.Now()
+	tests := []struct {
+		name string
+		lock *restic.Lock
+		want bool
+	}{
+		{
+			name: "recent lock held by a live local process",
+			lock: &restic.Lock{Time: now.Add(-time.Minute), Hostname: "local", Pid: os.Getpid()},
+			want: false,
+		},
+		{
+			name: "recent lock held by a dead local process",
+			lock: &restic.Lock{Time: now.Add(-time.Minute), Hostname: "local", Pid: exited.Process.Pid},
+			want: true,
+		},
+		{
+			name: "recent lock held by another host",
+			lock: &restic.Lock{Time: now.Add(-time.Minute), Hostname: "remote", Pid: exited.Process.Pid},
+			want: false,
+		},
+		{
+			name: "old lock held by another host",
+			lock: &restic.Lock{Time: now.Add(-2 * time.Hour), Hostname: "remote", Pid: 1},
+			want: true,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			if got := isStaleLock(tc.lock, time.Hour, "local", now); got != tc.want {
+				t.Errorf("isStaleLock() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestLocalRepoPath(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		uri       string
+		wantPath  string
+		wantLocal bool
+	}{
+		{uri: "/mnt/backups", wantPath: "/mnt/backups", wantLocal: true},
+		{uri: "local:/mnt/backups", wantPath: "/mnt/backups", wantLocal: true},
+		{uri: "sftp:user@host:/backups", wantLocal: false},
+		{uri: "s3:s3.amazonaws.com/bucket", wantLocal: false},
+	}
+
+	for _, tc := range tests {
+		path, local := localRepoPath(tc.uri)
+		if local != tc.wantLocal || (local && path != tc.wantPath) {
+			t.Errorf("localRepoPath(%q) = %q, %v, want %q, %v", tc.uri, path, local, tc.wantPath, tc.wantLocal)
+		}
+	}
+}
+
+func TestForgetRetriesLockedRepo(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		autoUnlock bool
+		wantErr    error
+	}{
+		{name: "auto unlock", autoUnlock: true},
+		{name: "no auto unlock", wantErr: restic.ErrRepoLocked},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			// the repo is locked by another host's lock from 2020 until restic unlock removes it.
+			unlocked := filepath.Join(t.TempDir(), "unlocked")
+			resticBin := fakeResticBinary(t, `case "$1" in
+forget)
+	if [ -e `+unlocked+` ]; then echo '[{"keep":[],"remove":[]}]'; exit 0; fi
+	echo "unable to create lock in backend: repository is already locked by PID 1 on otherhost" >&2; exit 11;;
+list) echo 0000000000000000000000000000000000000000000000000000000000000001;;
+cat) echo '{"time":"2020-01-01T00:00:00Z","exclusive":true,"hostname":"otherhost","pid":1}';;
+unlock) touch `+unlocked+`;;
+esac`)
+
+			r, err := NewRepoOrchestrator(&v1.Repo{Id: "test", Uri: t.TempDir(), Password: "test", AutoUnlock: tc.autoUnlock}, resticBin)
+			if err != nil {
+				t.Fatalf("failed to create repo orchestrator: %v", err)
+			}
+
+			_, err = r.Forget(context.Background(), &v1.Plan{Id: "test", Retention: &v1.RetentionPolicy{}})
+			if !errors.Is(err, tc.wantErr) {
+				t.Fatalf("forget error = %v, want %v", err, tc.wantErr)
+			}
+			if _, err := os.Stat(unlocked); (err == nil) != tc.autoUnlock {
+				t.Errorf("repo unlocked = %v, want %v", err == nil, tc.autoUnlock)
+			}
+		})
+	}
+}
+
+func TestOpenLocalRepoRemembersFailure(t *testing.T) {
+	t.Parallel()
+
+	// the directory holds no repo, opening it natively fails.
+	r, err := NewRepoOrchestrator(&v1.Repo{Id: "test", Uri: t.TempDir(), Password: "test"}, "restic")
+	if err != nil {
+		t.Fatalf("failed to create repo orchestrator: %v", err)
+	}
+
+	if repo := r.openLocalRepo(context.Background()); repo != nil {
+		t.Fatalf("expected opening an empty directory to fail")
+	}
+	openErr := r.localRepoErr
+	if openErr == nil {
+		t.Fatalf("expected the failure to be remembered")
+	}
+	if repo := r.openLocalRepo(context.Background()); repo != nil || r.localRepoErr != openErr {
+		t.Errorf("expected the repo not to be opened again, got error %v", r.localRepoErr)
+	}
+}
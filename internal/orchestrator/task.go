@@ -9,6 +9,7 @@ import (
 	"time"
 
 	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+	"github.com/garethgeorge/backrest/internal/hook"
 	"github.com/garethgeorge/backrest/internal/oplog"
 	"github.com/garethgeorge/backrest/pkg/restic"
 	"github.com/hashicorp/go-multierror"
@@ -65,21 +66,53 @@ func (t *TaskWithOperation) runWithOpAndContext(ctx context.Context, do func(ctx
 		buf := bytes.NewBuffer(nil)
 		ctx = restic.ContextWithLogger(ctx, buf)
 
-		err := do(ctx, t.op)
-
-		if bytes := buf.Bytes(); len(bytes) > 0 {
-			ref, e := t.orch.logStore.Write(bytes)
-			if e != nil {
-				errors.Join(err, fmt.Errorf("failed to write log to logstore: %w", e))
+		written := 0 // length of the log when it was last written.
+		writeLog := func() error {
+			if buf.Len() == written {
+				return nil
+			}
+			ref, err := t.orch.logStore.Write(buf.Bytes())
+			if err != nil {
+				return fmt.Errorf("failed to write log to logstore: %w", err)
 			}
 			t.op.Logref = ref
-			zap.S().Debug("wrote operation log to %v", ref)
+			written = buf.Len()
+			zap.S().Debugf("wrote operation log to %v", ref)
+			return nil
+		}
+		ctx = context.WithValue(ctx, flushLogKey{}, func() {
+			if err := writeLog(); err != nil {
+				zap.S().Errorf("flush operation log: %v", err)
+				return
+			}
+			if err := t.orch.OpLog.Update(t.op); err != nil {
+				zap.S().Errorf("flush operation log: update operation: %v", err)
+			}
+		})
+
+		err := do(ctx, t.op)
+		if err != nil && ctx.Err() != nil {
+			err = cancelledBy(ctx, err)
+		}
+
+		if e := writeLog(); e != nil {
+			zap.S().Error(e)
 		}
 
 		return err
 	})
 }
 
+type flushLogKey struct{}
+
+// flushLog writes the log captured so far for the operation run by runWithOpAndContext and records it on the operation, so that
+// hooks run before the task completes e.g. on the end of a backup can read it. The complete log replaces it when the task completes.
+func flushLog(ctx context.Context) {
+	if flush, ok := ctx.Value(flushLogKey{}).(func()); ok {
+		flush()
+	}
+}
+
 // Cancel marks a task as cancelled. Note that, unintuitively, it is actually an error to call cancel on a running task.
 func (t *TaskWithOperation) Cancel(withStatus v1.OperationStatus) error {
 	if t.running.Load() {
@@ -99,7 +132,7 @@ func (t *TaskWithOperation) Cancel(withStatus v1.OperationStatus) error {
 
 // WithOperation is a utility that creates an operation to track the function's execution.
 // timestamps are automatically added and the status is automatically updated if an error occurs.
-func WithOperation(oplog *oplog.OpLog, op *v1.Operation, do func() error) error {
+func WithOperation(oplog oplog.OpLog, op *v1.Operation, do func() error) error {
 	op.UnixTimeStartMs = curTimeMillis() // update the start time from the planned time to the actual time.
 	if op.Status == v1.OperationStatus_STATUS_PENDING || op.Status == v1.OperationStatus_STATUS_UNKNOWN {
 		op.Status = v1.OperationStatus_STATUS_INPROGRESS
@@ -116,6 +149,10 @@ func WithOperation(oplog *oplog.OpLog, op *v1.Operation, do func() error) error
 	err := do()
 	if err != nil {
 		op.Status = v1.OperationStatus_STATUS_ERROR
+		var cancelled *taskCancelledError
+		if errors.As(err, &cancelled) {
+			op.Status = cancelled.status
+		}
 		op.DisplayMessage = err.Error()
 	}
 	op.UnixTimeEndMs = curTimeMillis()
@@ -128,6 +165,54 @@ func WithOperation(oplog *oplog.OpLog, op *v1.Operation, do func() error) error
 	return err
 }
 
+// taskCancelledError is the cause of a task's context being cancelled, it records the status the task's operation should end with.
+type taskCancelledError struct {
+	status v1.OperationStatus
+	err    error
+}
+
+func (e *taskCancelledError) Error() string {
+	if e.err == nil {
+		return "operation cancelled"
+	}
+	return e.err.Error()
+}
+
+func (e *taskCancelledError) Unwrap() error {
+	return e.err
+}
+
+// cancelledBy wraps err, returned by a task whose context was cancelled, with the status requested by whoever cancelled it.
+// Cancellations without a requested status e.g. on shutdown are treated as system cancellations.
+func cancelledBy(ctx context.Context, err error) error {
+	status := v1.OperationStatus_STATUS_SYSTEM_CANCELLED
+	var cause *taskCancelledError
+	if errors.As(context.Cause(ctx), &cause) {
+		status = cause.status
+	}
+	return &taskCancelledError{status: status, err: err}
+}
+
+// skippedByHook reports whether err, returned by a task's start hooks, is a hook cancelling the task. If so the task's operation is marked as cancelled.
+func skippedByHook(err error, op *v1.Operation, task string) bool {
+	var hookErr *hook.HookError
+	if !errors.As(err, &hookErr) || !hookErr.Cancel {
+		return false
+	}
+	zap.L().Info(task+" skipped by hook", zap.Int64("opId", op.Id), zap.String("hook", hookErr.Name), zap.Error(hookErr.Err))
+	op.Status = v1.OperationStatus_STATUS_SYSTEM_CANCELLED
+	op.DisplayMessage = fmt.Sprintf("%v skipped by hook %v: %v", task, hookErr.Name, hookErr.Err)
+	return true
+}
+
+// errorKind returns the description of the restic failure that caused err, empty if the failure is not classified.
+func errorKind(err error) string {
+	if kind := restic.ErrorKind(err); kind != nil {
+		return kind.Error()
+	}
+	return ""
+}
+
 func timeToUnixMillis(t time.Time) int64 {
 	return t.Unix()*1000 + int64(t.Nanosecond()/1000000)
 }
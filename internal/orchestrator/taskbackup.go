@@ -107,11 +107,28 @@ func backupHelper(ctx context.Context, t Task, orchestrator *Orchestrator, plan
 		return fmt.Errorf("couldn't get repo %q: %w", plan.Repo, err)
 	}
 
-	orchestrator.hookExecutor.ExecuteHooks(repo.Config(), plan, "", []v1.Hook_Condition{
+	if err := orchestrator.hookExecutor.ExecuteHooks(ctx, repo.Config(), plan, "", []v1.Hook_Condition{
 		v1.Hook_CONDITION_SNAPSHOT_START,
 	}, hook.HookVars{
-		Task: t.Name(),
-	})
+		Task:        t.Name(),
+		OperationId: op.Id,
+	}); err != nil {
+		var hookErr *hook.HookError
+		if errors.As(err, &hookErr) && hookErr.Cancel {
+			zap.L().Info("Backup skipped by hook", zap.String("plan", plan.Id), zap.String("hook", hookErr.Name), zap.Error(hookErr.Err))
+			op.Status = v1.OperationStatus_STATUS_SYSTEM_CANCELLED
+			op.DisplayMessage = fmt.Sprintf("Backup skipped by hook %v: %v", hookErr.Name, hookErr.Err)
+			return nil
+		}
+		orchestrator.hookExecutor.ExecuteHooks(ctx, repo.Config(), plan, "", []v1.Hook_Condition{
+			v1.Hook_CONDITION_SNAPSHOT_ERROR, v1.Hook_CONDITION_ANY_ERROR,
+		}, hook.HookVars{
+			Task:        t.Name(),
+			Error:       err.Error(),
+			OperationId: op.Id,
+		})
+		return fmt.Errorf("snapshot start hook: %w", err)
+	}
 
 	lastSent := time.Now() // debounce progress updates, these can endup being very frequent.
 	var lastFiles []string
@@ -149,18 +166,23 @@ func backupHelper(ctx context.Context, t Task, orchestrator *Orchestrator, plan
 		}
 		lastSent = time.Now()
 
-		if err := orchestrator.OpLog.Update(op); err != nil {
+		if err := orchestrator.OpLog.UpdateProgress(op); err != nil {
 			zap.S().Errorf("failed to update oplog with progress for backup: %v", err)
 		}
 	})
 
+	// the end and error hooks can send the log of the backup, it's written now rather than when the task completes.
+	flushLog(ctx)
+
 	vars := hook.HookVars{
 		Task:          t.Name(),
 		SnapshotStats: summary,
+		OperationId:   op.Id,
 	}
 	if err != nil {
 		vars.Error = err.Error()
-		orchestrator.hookExecutor.ExecuteHooks(repo.Config(), plan, "", []v1.Hook_Condition{
+		vars.ErrorKind = errorKind(err)
+		orchestrator.hookExecutor.ExecuteHooks(ctx, repo.Config(), plan, "", []v1.Hook_Condition{
 			v1.Hook_CONDITION_SNAPSHOT_ERROR, v1.Hook_CONDITION_ANY_ERROR,
 		}, vars)
 
@@ -171,9 +193,11 @@ func backupHelper(ctx context.Context, t Task, orchestrator *Orchestrator, plan
 		op.DisplayMessage = "Partial backup, some files may not have been read completely."
 	}
 
-	orchestrator.hookExecutor.ExecuteHooks(repo.Config(), plan, summary.SnapshotId, []v1.Hook_Condition{
-		v1.Hook_CONDITION_SNAPSHOT_END,
-	}, vars)
+	endConditions := []v1.Hook_Condition{v1.Hook_CONDITION_SNAPSHOT_END}
+	if err == nil {
+		endConditions = append(endConditions, v1.Hook_CONDITION_ANY_SUCCESS)
+	}
+	orchestrator.hookExecutor.ExecuteHooks(ctx, repo.Config(), plan, summary.SnapshotId, endConditions, vars)
 
 	op.SnapshotId = summary.SnapshotId
 	backupOp.OperationBackup.LastStatus = protoutil.BackupProgressEntryToProto(summary)
@@ -0,0 +1,203 @@
+package orchestrator
+
+import (
+	"context"
+	"encoding/base64"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+	"github.com/garethgeorge/backrest/internal/oplog"
+	"github.com/garethgeorge/backrest/test/helpers"
+)
+
+// failingBackupRestic is a fake restic that initializes the repo and lists no snapshots but fails every backup.
+const failingBackupRestic = `case "$1" in
+snapshots) echo "[]" ;;
+backup) echo "Fatal: unable to save snapshot: disk full" >&2; exit 1 ;;
+esac`
+
+func TestBackupErrorHookAttachesLog(t *testing.T) {
+	t.Parallel()
+
+	server := helpers.NewSMTPServer(t)
+
+	cfg := &v1.Config{
+		Repos: []*v1.Repo{{Id: "repo1", Uri: t.TempDir(), Password: "test"}},
+		Plans: []*v1.Plan{{
+			Id:    "plan1",
+			Repo:  "repo1",
+			Paths: []string{t.TempDir()},
+			Cron:  "0 0 1 1 *",
+			Hooks: []*v1.Hook{{
+				Conditions: []v1.Hook_Condition{v1.Hook_CONDITION_SNAPSHOT_ERROR},
+				Action: &v1.Hook_ActionEmail{ActionEmail: &v1.Hook_Email{
+					Host:      server.Host,
+					Port:      int32(server.Port),
+					Security:  v1.Hook_Email_NONE,
+					From:      "backrest@example.com",
+					To:        []string{"admin@example.com"},
+					AttachLog: true,
+				}},
+			}},
+		}},
+	}
+	if err := runTestTask(t, failingBackupRestic, cfg, oplog.NewMemStore(), func(orch *Orchestrator) Task {
+		return NewOneoffBackupTask(orch, cfg.Plans[0], time.Now())
+	}); err == nil {
+		t.Fatalf("expected the backup to fail")
+	}
+
+	select {
+	case <-server.Done:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("no email was sent")
+	}
+	_, attachment, ok := strings.Cut(server.Data, "filename=operation.log")
+	if !ok {
+		t.Fatalf("expected the log to be attached, got %q", server.Data)
+	}
+	_, encoded, _ := strings.Cut(attachment, "\n\n")
+	encoded, _, _ = strings.Cut(encoded, "\n--")
+	log, err := base64.StdEncoding.DecodeString(strings.ReplaceAll(encoded, "\n", ""))
+	if err != nil {
+		t.Fatalf("failed to decode the attached log: %v", err)
+	}
+	if !strings.Contains(string(log), "restic backup --json") {
+		t.Errorf("expected the attached log to contain the backup command, got %q", log)
+	}
+}
+
+func TestBackupErrorHookPingsHealthcheckWithLog(t *testing.T) {
+	t.Parallel()
+
+	type ping struct{ path, body string }
+	pings := make(chan ping, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		pings <- ping{r.URL.Path, string(body)}
+	}))
+	defer server.Close()
+
+	cfg := &v1.Config{
+		Repos: []*v1.Repo{{Id: "repo1", Uri: t.TempDir(), Password: "test"}},
+		Plans: []*v1.Plan{{
+			Id:    "plan1",
+			Repo:  "repo1",
+			Paths: []string{t.TempDir()},
+			Cron:  "0 0 1 1 *",
+			Hooks: []*v1.Hook{{
+				Conditions: []v1.Hook_Condition{v1.Hook_CONDITION_SNAPSHOT_ERROR},
+				Action: &v1.Hook_ActionHealthcheck{ActionHealthcheck: &v1.Hook_Healthcheck{
+					PingUrl: server.URL + "/check",
+				}},
+			}},
+		}},
+	}
+	if err := runTestTask(t, failingBackupRestic, cfg, oplog.NewMemStore(), func(orch *Orchestrator) Task {
+		return NewOneoffBackupTask(orch, cfg.Plans[0], time.Now())
+	}); err == nil {
+		t.Fatalf("expected the backup to fail")
+	}
+	select {
+	case p := <-pings:
+		if p.path != "/check/fail" {
+			t.Errorf("pinged %q, want /check/fail", p.path)
+		}
+		if !strings.Contains(p.body, "---- log ----") || !strings.Contains(p.body, "restic backup --json") {
+			t.Errorf("expected the ping's body to contain the backup's log, got %q", p.body)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("no ping was sent")
+	}
+}
+
+func TestBackupCancelledStatus(t *testing.T) {
+	t.Parallel()
+
+	tcs := []struct {
+		name   string
+		cancel func(orch *Orchestrator, opId int64, shutdown context.CancelFunc) error
+		want   v1.OperationStatus
+	}{
+		{
+			name: "cancelled by user",
+			cancel: func(orch *Orchestrator, opId int64, shutdown context.CancelFunc) error {
+				return orch.CancelOperation(opId, v1.OperationStatus_STATUS_USER_CANCELLED)
+			},
+			want: v1.OperationStatus_STATUS_USER_CANCELLED,
+		},
+		{
+			name: "shutdown",
+			cancel: func(orch *Orchestrator, opId int64, shutdown context.CancelFunc) error {
+				shutdown()
+				return nil
+			},
+			want: v1.OperationStatus_STATUS_SYSTEM_CANCELLED,
+		},
+	}
+
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			cfg := &v1.Config{
+				Repos: []*v1.Repo{{Id: "repo1", Uri: t.TempDir(), Password: "test"}},
+				Plans: []*v1.Plan{{Id: "plan1", Repo: "repo1", Paths: []string{t.TempDir()}, Cron: "0 0 1 1 *"}},
+			}
+			log := oplog.NewMemStore()
+			orch := newTestOrchestrator(t, `case "$1" in
+snapshots) echo "[]" ;;
+backup) exec sleep 30 ;;
+esac`, cfg, log)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			done := make(chan struct{})
+			go func() {
+				orch.Run(ctx)
+				close(done)
+			}()
+			orch.ScheduleTask(NewOneoffBackupTask(orch, cfg.Plans[0], time.Now()), TaskPriorityInteractive)
+
+			backupOp := func() *v1.Operation {
+				var found *v1.Operation
+				if _, err := log.Query(oplog.Query{PlanId: "plan1", Types: []v1.OperationType{v1.OperationType_TYPE_BACKUP}}, func(op *v1.Operation) error {
+					found = op
+					return nil
+				}); err != nil {
+					t.Fatalf("failed to query operations: %v", err)
+				}
+				return found
+			}
+			waitFor := func(cond func(op *v1.Operation) bool) *v1.Operation {
+				deadline := time.Now().Add(10 * time.Second)
+				for time.Now().Before(deadline) {
+					if op := backupOp(); op != nil && cond(op) {
+						return op
+					}
+					time.Sleep(10 * time.Millisecond)
+				}
+				t.Fatalf("timed out waiting for the backup operation, last seen %v", backupOp())
+				return nil
+			}
+
+			op := waitFor(func(op *v1.Operation) bool { return op.Status == v1.OperationStatus_STATUS_INPROGRESS })
+			if err := tc.cancel(orch, op.Id, cancel); err != nil {
+				t.Fatalf("failed to cancel the backup: %v", err)
+			}
+			op = waitFor(func(op *v1.Operation) bool { return op.UnixTimeEndMs != 0 })
+			if op.Status != tc.want {
+				t.Errorf("backup status = %v, want %v", op.Status, tc.want)
+			}
+
+			cancel()
+			<-done
+		})
+	}
+}
@@ -2,24 +2,27 @@ package orchestrator
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"reflect"
+	"slices"
 	"time"
 
 	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+	"github.com/garethgeorge/backrest/internal/oplog"
+	"github.com/garethgeorge/backrest/internal/protoutil"
 	"go.uber.org/zap"
 )
 
 const (
 	gcStartupDelay = 5 * time.Second
-	gcInterval     = 24 * time.Hour
-	// keep operations that are eligible for gc for 30 days OR up to a limit of 100 for any one plan.
-	// an operation is eligible for gc if:
-	// - it has no snapshot associated with it
-	// - it has a forgotten snapshot associated with it
-	gcHistoryAge      = 30 * 24 * time.Hour
-	gcHistoryMaxCount = 1000
-	// keep stats operations for 1 year (they're small and useful for long term trends)
-	gcHistoryStatsAge = 365 * 24 * time.Hour
+	// defaults for the fields of v1.GcPolicy that are not set.
+	gcDefaultInterval     = 24 * time.Hour
+	gcDefaultHistoryAge   = 30 * 24 * time.Hour
+	gcDefaultMaxCount     = 1000
+	gcDefaultHistoryStats = 365 * 24 * time.Hour // stats operations are small and useful for long term trends.
+
+	gcPageSize = 256 // operations read per transaction while collecting.
 )
 
 type CollectGarbageTask struct {
@@ -40,95 +43,373 @@ func (t *CollectGarbageTask) Next(now time.Time) *time.Time {
 		return &runAt
 	}
 
-	runAt := now.Add(gcInterval)
+	interval := gcDefaultInterval
+	t.orchestrator.mu.Lock()
+	if hours := t.orchestrator.config.GetGcPolicy().GetIntervalHours(); hours > 0 {
+		interval = time.Duration(hours) * time.Hour
+	}
+	t.orchestrator.mu.Unlock()
+
+	runAt := now.Add(interval)
 	return &runAt
 }
 
 func (t *CollectGarbageTask) Run(ctx context.Context) error {
-	if err := t.gcOperations(); err != nil {
+	if _, err := t.orchestrator.RunGarbageCollection(ctx); err != nil {
 		return fmt.Errorf("collecting garbage: %w", err)
 	}
 
 	return nil
 }
 
-func (t *CollectGarbageTask) gcOperations() error {
-	oplog := t.orchestrator.OpLog
+func (t *CollectGarbageTask) Cancel(withStatus v1.OperationStatus) error {
+	return nil
+}
 
-	// pass 1: identify forgotten snapshots.
-	snapshotIsForgotten := make(map[string]bool)
-	if err := oplog.ForAll(func(op *v1.Operation) error {
-		if snapshotOp, ok := op.Op.(*v1.Operation_OperationIndexSnapshot); ok {
-			if snapshotOp.OperationIndexSnapshot.Forgot {
-				snapshotIsForgotten[snapshotOp.OperationIndexSnapshot.Snapshot.Id] = true
-			}
+func (t *CollectGarbageTask) OperationId() int64 {
+	return 0
+}
+
+// RunGarbageCollection removes the operations that are past their plan's gc policy from the log.
+func (o *Orchestrator) RunGarbageCollection(ctx context.Context) (*v1.GarbageCollectionResult, error) {
+	o.gcMu.Lock()
+	defer o.gcMu.Unlock()
+
+	o.mu.Lock()
+	policies := newGcPolicies(o.config)
+	o.mu.Unlock()
+
+	// read before visiting any operation so that changes made during the run are visited again by the next run.
+	seq, err := o.OpLog.CurrentSeq()
+	if err != nil {
+		return nil, fmt.Errorf("reading oplog seq: %w", err)
+	}
+
+	gc := &garbageCollector{
+		oplog:     o.OpLog,
+		policies:  policies,
+		now:       o.curTime(),
+		forgotten: make(map[string]bool),
+		removed:   make(map[int64]bool),
+		result: &v1.GarbageCollectionResult{
+			RemovedByPlan: make(map[string]int64),
+			RemovedByType: make(map[string]int64),
+		},
+	}
+	if err := gc.collect(ctx, o.gcLastRun); err != nil {
+		return nil, err
+	}
+
+	if err := o.OpLog.Delete(gc.result.RemovedIds...); err != nil {
+		return nil, fmt.Errorf("removing gc eligible operations: %w", err)
+	}
+	o.gcLastRun = &gcRun{now: gc.now, seq: seq, policies: policies}
+
+	zap.L().Info("collecting garbage",
+		zap.Int("snapshots_checked", len(gc.forgotten)),
+		zap.Int("operations_removed", len(gc.result.RemovedIds)))
+	return gc.result, nil
+}
+
+// gcRun records a completed garbage collection run, the next run only revisits the operations that may have become eligible since.
+type gcRun struct {
+	now      time.Time
+	seq      int64 // oplog seq before the run visited any operation.
+	policies *gcPolicies
+}
+
+// gcPolicy is a v1.GcPolicy with its defaults applied.
+type gcPolicy struct {
+	maxAge       time.Duration
+	maxAgeByType map[v1.OperationType]time.Duration
+	maxCount     int
+	failedMaxAge time.Duration
+}
+
+// maxAgeOf returns how long the operation is kept.
+func (p *gcPolicy) maxAgeOf(op *v1.Operation) time.Duration {
+	age := p.maxAge
+	if typeAge, ok := p.maxAgeByType[protoutil.OperationType(op)]; ok {
+		age = typeAge
+	}
+	if op.Status == v1.OperationStatus_STATUS_ERROR && p.failedMaxAge > age {
+		age = p.failedMaxAge
+	}
+	return age
+}
+
+// gcPolicies resolves the gc policy of each plan, operations of plans that aren't configured use the config's policy.
+type gcPolicies struct {
+	defaultPolicy *gcPolicy
+	byPlan        map[string]*gcPolicy
+}
+
+func newGcPolicies(cfg *v1.Config) *gcPolicies {
+	policies := &gcPolicies{
+		defaultPolicy: resolveGcPolicy(&gcPolicy{
+			maxAge:       gcDefaultHistoryAge,
+			maxAgeByType: map[v1.OperationType]time.Duration{v1.OperationType_TYPE_STATS: gcDefaultHistoryStats},
+			maxCount:     gcDefaultMaxCount,
+		}, cfg.GetGcPolicy()),
+		byPlan: make(map[string]*gcPolicy),
+	}
+	for _, plan := range cfg.GetPlans() {
+		if plan.GcPolicy != nil {
+			policies.byPlan[plan.Id] = resolveGcPolicy(policies.defaultPolicy, plan.GcPolicy)
 		}
-		return nil
-	}); err != nil {
-		return fmt.Errorf("identifying forgotten snapshots: %w", err)
-	}
-
-	// pass 2: identify operations that are gc eligible
-	//  - any operation that has no snapshot associated with it
-	//  - any operation that has a forgotten snapshot associated with it
-	operationsByPlan := make(map[string][]gcOpInfo)
-	if err := oplog.ForAll(func(op *v1.Operation) error {
-		if op.SnapshotId == "" || snapshotIsForgotten[op.SnapshotId] {
-			_, isStats := op.Op.(*v1.Operation_OperationStats)
-			operationsByPlan[op.PlanId] = append(operationsByPlan[op.PlanId], gcOpInfo{
-				id:        op.Id,
-				timestamp: op.UnixTimeStartMs,
-				isStats:   isStats,
-			})
+	}
+	return policies
+}
+
+// resolveGcPolicy returns base with the fields set in override applied.
+func resolveGcPolicy(base *gcPolicy, override *v1.GcPolicy) *gcPolicy {
+	p := *base
+	p.maxAgeByType = make(map[v1.OperationType]time.Duration)
+	for opType, age := range base.maxAgeByType {
+		p.maxAgeByType[opType] = age
+	}
+
+	if override.GetMaxAgeDays() > 0 {
+		p.maxAge = time.Duration(override.GetMaxAgeDays()) * 24 * time.Hour
+	}
+	for name, days := range override.GetMaxAgeDaysByType() {
+		p.maxAgeByType[v1.OperationType(v1.OperationType_value[name])] = time.Duration(days) * 24 * time.Hour
+	}
+	if override.GetMaxCount() > 0 {
+		p.maxCount = int(override.GetMaxCount())
+	}
+	if override.GetFailedMaxAgeDays() > 0 {
+		p.failedMaxAge = time.Duration(override.GetFailedMaxAgeDays()) * 24 * time.Hour
+	}
+	return &p
+}
+
+func (p *gcPolicies) forPlan(planId string) *gcPolicy {
+	if policy, ok := p.byPlan[planId]; ok {
+		return policy
+	}
+	return p.defaultPolicy
+}
+
+// minAge returns the shortest age any operation is kept for, only older operations need to be visited by the age pass.
+func (p *gcPolicies) minAge() time.Duration {
+	minAge := p.defaultPolicy.minAge()
+	for _, policy := range p.byPlan {
+		minAge = min(minAge, policy.minAge())
+	}
+	return minAge
+}
+
+// ages returns every distinct age an operation may be kept for.
+func (p *gcPolicies) ages() []time.Duration {
+	var ages []time.Duration
+	add := func(age time.Duration) {
+		if age > 0 && !slices.Contains(ages, age) {
+			ages = append(ages, age)
 		}
-		return nil
-	}); err != nil {
-		return fmt.Errorf("identifying gc eligible operations: %w", err)
 	}
+	addPolicy := func(policy *gcPolicy) {
+		add(policy.maxAge)
+		add(policy.failedMaxAge)
+		for _, age := range policy.maxAgeByType {
+			add(age)
+		}
+	}
+	addPolicy(p.defaultPolicy)
+	for _, policy := range p.byPlan {
+		addPolicy(policy)
+	}
+	return ages
+}
 
-	var gcOps []int64
-	curTime := curTimeMillis()
-	for _, opInfos := range operationsByPlan {
-		if len(opInfos) >= gcHistoryMaxCount {
-			for _, opInfo := range opInfos[:len(opInfos)-gcHistoryMaxCount] {
-				gcOps = append(gcOps, opInfo.id)
-			}
-			opInfos = opInfos[len(opInfos)-gcHistoryMaxCount:]
+func (p *gcPolicy) minAge() time.Duration {
+	minAge := p.maxAge
+	for _, age := range p.maxAgeByType {
+		minAge = min(minAge, age)
+	}
+	return minAge
+}
+
+type garbageCollector struct {
+	oplog    oplog.OpLog
+	policies *gcPolicies
+	now      time.Time
+
+	forgotten map[string]bool // whether each visited snapshot is forgotten.
+	removed   map[int64]bool
+	result    *v1.GarbageCollectionResult
+}
+
+// collect finds the operations to remove. Rather than scanning the whole log it visits the operations past their max age, see
+// collectOld, then the oldest operations of the plans that exceed their max count.
+func (gc *garbageCollector) collect(ctx context.Context, lastRun *gcRun) error {
+	if err := gc.collectOld(ctx, lastRun); err != nil {
+		return fmt.Errorf("collecting old operations: %w", err)
+	}
+
+	counts, err := gc.oplog.CountByPlan()
+	if err != nil {
+		return fmt.Errorf("counting operations: %w", err)
+	}
+	for planId, count := range counts {
+		excess := count - gc.policies.forPlan(planId).maxCount - int(gc.result.RemovedByPlan[planId])
+		if excess <= 0 {
+			continue
 		}
 
-		// check if each operation timestamp is old.
-		for _, opInfo := range opInfos {
-			maxAgeForType := gcHistoryAge.Milliseconds()
-			if opInfo.isStats {
-				maxAgeForType = gcHistoryStatsAge.Milliseconds()
+		// an empty plan id matches every operation, operations without a plan are filtered out of all operations.
+		if err := gc.visit(ctx, oplog.Query{PlanId: planId}, func(op *v1.Operation) error {
+			if gc.removed[op.Id] || op.PlanId != planId {
+				return nil
 			}
-			if curTime-opInfo.timestamp > maxAgeForType {
-				gcOps = append(gcOps, opInfo.id)
+			if removed, err := gc.removeIfEligible(op); err != nil {
+				return err
+			} else if removed {
+				excess--
 			}
+			if excess <= 0 {
+				return oplog.ErrStopIteration
+			}
+			return nil
+		}); err != nil {
+			return fmt.Errorf("collecting operations of plan %q: %w", planId, err)
 		}
 	}
+	return nil
+}
 
-	// pass 3: remove gc eligible operations
-	if err := oplog.Delete(gcOps...); err != nil {
-		return fmt.Errorf("removing gc eligible operations: %w", err)
+// collectOld removes the eligible operations past their max age. The first run, and any run after the policies changed or the
+// journal dropped events, visits every operation older than the shortest max age. Later runs start from the previous run's
+// watermark, operations it kept only become eligible once they pass their max age or change e.g. complete or have their
+// snapshot forgotten. So they visit the operations that passed one of the max ages since, and those changed since per the
+// journal.
+func (gc *garbageCollector) collectOld(ctx context.Context, lastRun *gcRun) error {
+	removeIfOld := func(op *v1.Operation) error {
+		if gc.removed[op.Id] || gc.now.UnixMilli()-op.UnixTimeStartMs <= gc.policies.forPlan(op.PlanId).maxAgeOf(op).Milliseconds() {
+			return nil
+		}
+		_, err := gc.removeIfEligible(op)
+		return err
 	}
 
-	zap.L().Info("collecting garbage",
-		zap.Int("forgotten_snapshots", len(snapshotIsForgotten)),
-		zap.Any("operations_removed", len(gcOps)))
+	var events []*v1.OperationEvent
+	if lastRun != nil && reflect.DeepEqual(lastRun.policies, gc.policies) {
+		var err error
+		if events, _, err = gc.oplog.EventsSince(lastRun.seq); errors.Is(err, oplog.ErrResyncRequired) {
+			lastRun = nil
+		} else if err != nil {
+			return fmt.Errorf("reading journal: %w", err)
+		}
+	} else {
+		lastRun = nil
+	}
+	if lastRun == nil {
+		return gc.visit(ctx, oplog.Query{EndTimeMs: gc.now.Add(-gc.policies.minAge()).UnixMilli()}, removeIfOld)
+	}
+
+	for _, age := range gc.policies.ages() {
+		q := oplog.Query{StartTimeMs: lastRun.now.Add(-age).UnixMilli(), EndTimeMs: gc.now.Add(-age).UnixMilli()}
+		if q.StartTimeMs >= q.EndTimeMs {
+			continue
+		}
+		if err := gc.visit(ctx, q, removeIfOld); err != nil {
+			return err
+		}
+	}
+
+	for _, event := range events {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if event.Type == v1.OperationEventType_EVENT_DELETED {
+			continue
+		}
+		// the journal holds the operation as of the event, it may have changed or been removed since.
+		op, err := gc.oplog.Get(event.Operation.Id)
+		if errors.Is(err, oplog.ErrNotExist) {
+			continue
+		} else if err != nil {
+			return fmt.Errorf("getting operation %v: %w", event.Operation.Id, err)
+		}
+		if err := removeIfOld(op); err != nil {
+			return err
+		}
+		if op.GetOperationIndexSnapshot().GetForgot() {
+			if err := gc.visit(ctx, oplog.Query{SnapshotId: op.SnapshotId}, removeIfOld); err != nil {
+				return err
+			}
+		}
+	}
 	return nil
 }
 
-func (t *CollectGarbageTask) Cancel(withStatus v1.OperationStatus) error {
-	return nil
+// visit calls do for the operations matching the query in time order. Operations are read in pages so that do runs outside
+// of the log's read transaction and may query the log itself.
+func (gc *garbageCollector) visit(ctx context.Context, q oplog.Query, do func(op *v1.Operation) error) error {
+	q.Limit = gcPageSize
+	for {
+		var page []*v1.Operation
+		cursor, err := gc.oplog.Query(q, func(op *v1.Operation) error {
+			page = append(page, op)
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		for _, op := range page {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			if err := do(op); err == oplog.ErrStopIteration {
+				return nil
+			} else if err != nil {
+				return err
+			}
+		}
+		if cursor == 0 {
+			return nil
+		}
+		q.Cursor = cursor
+	}
 }
 
-func (t *CollectGarbageTask) OperationId() int64 {
-	return 0
+// removeIfEligible adds the operation to the result if it is eligible for gc, that is if it is complete and either:
+//   - it has no snapshot associated with it
+//   - it has a forgotten snapshot associated with it
+func (gc *garbageCollector) removeIfEligible(op *v1.Operation) (bool, error) {
+	if op.Status == v1.OperationStatus_STATUS_PENDING || op.Status == v1.OperationStatus_STATUS_INPROGRESS {
+		return false, nil
+	}
+	if op.SnapshotId != "" {
+		forgotten, err := gc.isForgotten(op.SnapshotId)
+		if err != nil {
+			return false, err
+		}
+		if !forgotten {
+			return false, nil
+		}
+	}
+
+	gc.removed[op.Id] = true
+	gc.result.RemovedIds = append(gc.result.RemovedIds, op.Id)
+	gc.result.RemovedByPlan[op.PlanId]++
+	gc.result.RemovedByType[protoutil.OperationType(op).String()]++
+	return true, nil
 }
 
-type gcOpInfo struct {
-	id        int64 // operation ID
-	timestamp int64 // unix time milliseconds
-	isStats   bool  // true if this is a stats operation
+// isForgotten returns true if the snapshot was indexed and has since been forgotten.
+func (gc *garbageCollector) isForgotten(snapshotId string) (bool, error) {
+	if forgotten, ok := gc.forgotten[snapshotId]; ok {
+		return forgotten, nil
+	}
+
+	forgotten := false
+	if _, err := gc.oplog.Query(oplog.Query{SnapshotId: snapshotId, Types: []v1.OperationType{v1.OperationType_TYPE_INDEX_SNAPSHOT}}, func(op *v1.Operation) error {
+		forgotten = op.GetOperationIndexSnapshot().GetForgot()
+		return nil
+	}); err != nil {
+		return false, fmt.Errorf("looking up snapshot %v: %w", snapshotId, err)
+	}
+	gc.forgotten[snapshotId] = forgotten
+	return forgotten, nil
 }
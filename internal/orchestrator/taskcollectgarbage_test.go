@@ -0,0 +1,159 @@
+package orchestrator
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+	"github.com/garethgeorge/backrest/internal/config"
+	"github.com/garethgeorge/backrest/internal/oplog"
+)
+
+func TestRunGarbageCollection(t *testing.T) {
+	t.Parallel()
+
+	log, err := oplog.NewBoltStore(t.TempDir() + "/oplog.boltdb")
+	if err != nil {
+		t.Fatalf("failed to create oplog: %v", err)
+	}
+	t.Cleanup(func() { log.Close() })
+
+	now := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	daysAgo := func(days int) int64 {
+		return now.Add(-time.Duration(days) * 24 * time.Hour).UnixMilli()
+	}
+	liveSnapshot := strings.Repeat("a", 64)
+	forgottenSnapshot := strings.Repeat("b", 64)
+
+	ops := []*v1.Operation{
+		{DisplayMessage: "old", PlanId: "plan1", UnixTimeStartMs: daysAgo(15), Status: v1.OperationStatus_STATUS_SUCCESS, Op: &v1.Operation_OperationBackup{}},
+		{DisplayMessage: "old failed", PlanId: "plan1", UnixTimeStartMs: daysAgo(15), Status: v1.OperationStatus_STATUS_ERROR, Op: &v1.Operation_OperationBackup{}},
+		{DisplayMessage: "older failed", PlanId: "plan1", UnixTimeStartMs: daysAgo(25), Status: v1.OperationStatus_STATUS_ERROR, Op: &v1.Operation_OperationBackup{}},
+		{DisplayMessage: "old live snapshot", PlanId: "plan1", SnapshotId: liveSnapshot, UnixTimeStartMs: daysAgo(15), Status: v1.OperationStatus_STATUS_SUCCESS, Op: &v1.Operation_OperationBackup{}},
+		{DisplayMessage: "live snapshot", PlanId: "plan1", SnapshotId: liveSnapshot, UnixTimeStartMs: daysAgo(15), Status: v1.OperationStatus_STATUS_SUCCESS, Op: &v1.Operation_OperationIndexSnapshot{OperationIndexSnapshot: &v1.OperationIndexSnapshot{}}},
+		{DisplayMessage: "old forgotten snapshot", PlanId: "plan1", SnapshotId: forgottenSnapshot, UnixTimeStartMs: daysAgo(15), Status: v1.OperationStatus_STATUS_SUCCESS, Op: &v1.Operation_OperationIndexSnapshot{OperationIndexSnapshot: &v1.OperationIndexSnapshot{Forgot: true}}},
+		{DisplayMessage: "old stats", PlanId: "plan1", UnixTimeStartMs: daysAgo(40), Status: v1.OperationStatus_STATUS_SUCCESS, Op: &v1.Operation_OperationStats{}},
+		{DisplayMessage: "plan2 1", PlanId: "plan2", UnixTimeStartMs: daysAgo(4), Status: v1.OperationStatus_STATUS_SUCCESS, Op: &v1.Operation_OperationBackup{}},
+		{DisplayMessage: "plan2 2", PlanId: "plan2", UnixTimeStartMs: daysAgo(3), Status: v1.OperationStatus_STATUS_SUCCESS, Op: &v1.Operation_OperationBackup{}},
+		{DisplayMessage: "plan2 3", PlanId: "plan2", UnixTimeStartMs: daysAgo(2), Status: v1.OperationStatus_STATUS_SUCCESS, Op: &v1.Operation_OperationBackup{}},
+		{DisplayMessage: "plan2 4", PlanId: "plan2", UnixTimeStartMs: daysAgo(1), Status: v1.OperationStatus_STATUS_SUCCESS, Op: &v1.Operation_OperationBackup{}},
+	}
+	for _, op := range ops {
+		op.RepoId = "repo1"
+		if err := log.Add(op); err != nil {
+			t.Fatalf("failed to add operation: %v", err)
+		}
+	}
+
+	cfg := config.NewDefaultConfig()
+	cfg.GcPolicy = &v1.GcPolicy{MaxAgeDays: 10, FailedMaxAgeDays: 20}
+	cfg.Plans = []*v1.Plan{
+		{Id: "plan2", Disabled: true, GcPolicy: &v1.GcPolicy{MaxCount: 2}},
+	}
+	orch, err := NewOrchestrator("", cfg, log, nil)
+	if err != nil {
+		t.Fatalf("failed to create orchestrator: %v", err)
+	}
+	orch.now = func() time.Time { return now }
+
+	result, err := orch.RunGarbageCollection(context.Background())
+	if err != nil {
+		t.Fatalf("failed to run garbage collection: %v", err)
+	}
+	if len(result.RemovedIds) != 5 || result.RemovedByPlan["plan1"] != 3 || result.RemovedByPlan["plan2"] != 2 ||
+		result.RemovedByType["TYPE_BACKUP"] != 4 || result.RemovedByType["TYPE_INDEX_SNAPSHOT"] != 1 {
+		t.Errorf("unexpected result: %v", result)
+	}
+
+	var remaining []string
+	if _, err := log.Query(oplog.Query{}, func(op *v1.Operation) error {
+		remaining = append(remaining, op.DisplayMessage)
+		return nil
+	}); err != nil {
+		t.Fatalf("failed to query operations: %v", err)
+	}
+	want := []string{"old failed", "old live snapshot", "live snapshot", "old stats", "plan2 3", "plan2 4"}
+	if !slices.Equal(remaining, want) {
+		t.Errorf("want remaining operations %v, got %v", want, remaining)
+	}
+}
+
+func TestRunGarbageCollectionIncremental(t *testing.T) {
+	t.Parallel()
+
+	log, err := oplog.NewBoltStore(t.TempDir() + "/oplog.boltdb")
+	if err != nil {
+		t.Fatalf("failed to create oplog: %v", err)
+	}
+	t.Cleanup(func() { log.Close() })
+
+	now := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	daysAgo := func(days int) int64 {
+		return now.Add(-time.Duration(days) * 24 * time.Hour).UnixMilli()
+	}
+	snapshot := strings.Repeat("a", 64)
+
+	failed := &v1.Operation{DisplayMessage: "failed", UnixTimeStartMs: daysAgo(19), Status: v1.OperationStatus_STATUS_ERROR, Op: &v1.Operation_OperationBackup{}}
+	inProgress := &v1.Operation{DisplayMessage: "in progress", UnixTimeStartMs: daysAgo(15), Status: v1.OperationStatus_STATUS_INPROGRESS, Op: &v1.Operation_OperationBackup{}}
+	backup := &v1.Operation{DisplayMessage: "backup", SnapshotId: snapshot, UnixTimeStartMs: daysAgo(15), Status: v1.OperationStatus_STATUS_SUCCESS, Op: &v1.Operation_OperationBackup{}}
+	index := &v1.Operation{DisplayMessage: "index", SnapshotId: snapshot, UnixTimeStartMs: daysAgo(15), Status: v1.OperationStatus_STATUS_SUCCESS, Op: &v1.Operation_OperationIndexSnapshot{OperationIndexSnapshot: &v1.OperationIndexSnapshot{}}}
+	kept := &v1.Operation{DisplayMessage: "kept", UnixTimeStartMs: daysAgo(5), Status: v1.OperationStatus_STATUS_SUCCESS, Op: &v1.Operation_OperationBackup{}}
+	cfg := config.NewDefaultConfig()
+	cfg.GcPolicy = &v1.GcPolicy{MaxAgeDays: 10, FailedMaxAgeDays: 20}
+	orch, err := NewOrchestrator("", cfg, log, nil)
+	if err != nil {
+		t.Fatalf("failed to create orchestrator: %v", err)
+	}
+	orch.now = func() time.Time { return now }
+
+	for _, op := range []*v1.Operation{failed, inProgress, backup, index, kept} {
+		op.PlanId = "plan1"
+		op.RepoId = "repo1"
+		if err := log.Add(op); err != nil {
+			t.Fatalf("failed to add operation: %v", err)
+		}
+	}
+
+	removed := func() []string {
+		t.Helper()
+		result, err := orch.RunGarbageCollection(context.Background())
+		if err != nil {
+			t.Fatalf("failed to run garbage collection: %v", err)
+		}
+		var messages []string
+		for _, id := range result.RemovedIds {
+			for _, op := range []*v1.Operation{failed, inProgress, backup, index, kept} {
+				if op.Id == id {
+					messages = append(messages, op.DisplayMessage)
+				}
+			}
+		}
+		return messages
+	}
+
+	if got := removed(); len(got) != 0 {
+		t.Fatalf("want nothing removed by the first run, got %v", got)
+	}
+
+	// between runs the failed operation passes its max age, the in progress one completes and the snapshot is forgotten.
+	now = now.Add(2 * 24 * time.Hour)
+	inProgress.Status = v1.OperationStatus_STATUS_SUCCESS
+	index.GetOperationIndexSnapshot().Forgot = true
+	for _, op := range []*v1.Operation{inProgress, index} {
+		if err := log.Update(op); err != nil {
+			t.Fatalf("failed to update operation: %v", err)
+		}
+	}
+
+	got := removed()
+	slices.Sort(got)
+	if want := []string{"backup", "failed", "in progress", "index"}; !slices.Equal(got, want) {
+		t.Errorf("want removed operations %v, got %v", want, got)
+	}
+	if orch.gcLastRun == nil || !orch.gcLastRun.now.Equal(now) {
+		t.Errorf("want the watermark to advance to %v, got %v", now, orch.gcLastRun)
+	}
+}
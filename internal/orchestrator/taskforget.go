@@ -7,7 +7,7 @@ import (
 
 	v1 "github.com/garethgeorge/backrest/gen/go/v1"
 	"github.com/garethgeorge/backrest/internal/hook"
-	"github.com/garethgeorge/backrest/internal/oplog/indexutil"
+	"github.com/garethgeorge/backrest/internal/oplog"
 	"github.com/hashicorp/go-multierror"
 	"go.uber.org/zap"
 )
@@ -58,7 +58,11 @@ func (t *ForgetTask) Next(now time.Time) *time.Time {
 }
 
 func (t *ForgetTask) Run(ctx context.Context) error {
+	var forgotten []*v1.ResticSnapshot
+	var opId int64
+	cancelled := false
 	if err := t.runWithOpAndContext(ctx, func(ctx context.Context, op *v1.Operation) error {
+		opId = op.Id
 		forgetOp := &v1.Operation_OperationForget{
 			OperationForget: &v1.OperationForget{},
 		}
@@ -70,22 +74,31 @@ func (t *ForgetTask) Run(ctx context.Context) error {
 			return fmt.Errorf("get repo %q: %w", t.plan.Repo, err)
 		}
 
-		err = repo.UnlockIfAutoEnabled(ctx)
-		if err != nil {
-			return fmt.Errorf("auto unlock repo %q: %w", t.plan.Repo, err)
+		if err := t.orch.hookExecutor.ExecuteHooks(ctx, repo.Config(), t.plan, t.linkSnapshot, []v1.Hook_Condition{
+			v1.Hook_CONDITION_FORGET_START,
+		}, hook.HookVars{
+			Task:        t.Name(),
+			OperationId: opId,
+		}); err != nil {
+			if skippedByHook(err, op, "Forget") {
+				cancelled = true
+				return nil
+			}
+			return fmt.Errorf("forget start hook: %w", err)
 		}
 
 		forgot, err := repo.Forget(ctx, t.plan)
 		if err != nil {
 			return fmt.Errorf("forget: %w", err)
 		}
+		forgotten = forgot
 
 		forgetOp.OperationForget.Forget = append(forgetOp.OperationForget.Forget, forgot...)
 		forgetOp.OperationForget.Policy = t.plan.Retention
 
 		var ops []*v1.Operation
 		for _, forgot := range forgot {
-			if e := t.orch.OpLog.ForEachBySnapshotId(forgot.Id, indexutil.CollectAll(), func(op *v1.Operation) error {
+			if _, e := t.orch.OpLog.Query(oplog.Query{SnapshotId: forgot.Id}, func(op *v1.Operation) error {
 				ops = append(ops, op)
 				return nil
 			}); e != nil {
@@ -109,12 +122,30 @@ func (t *ForgetTask) Run(ctx context.Context) error {
 		return err
 	}); err != nil {
 		repo, _ := t.orch.GetRepo(t.plan.Repo)
-		t.orch.hookExecutor.ExecuteHooks(repo.Config(), t.plan, t.linkSnapshot, []v1.Hook_Condition{
-			v1.Hook_CONDITION_ANY_ERROR,
+		t.orch.hookExecutor.ExecuteHooks(ctx, repo.Config(), t.plan, t.linkSnapshot, []v1.Hook_Condition{
+			v1.Hook_CONDITION_FORGET_ERROR, v1.Hook_CONDITION_ANY_ERROR,
 		}, hook.HookVars{
-			Task:  t.Name(),
-			Error: err.Error(),
+			Task:        t.Name(),
+			Error:       err.Error(),
+			ErrorKind:   errorKind(err),
+			Forgotten:   forgotten,
+			OperationId: opId,
 		})
+		return nil
+	}
+
+	// a task skipped by its start hooks doesn't run its success hooks.
+	if cancelled {
+		return nil
 	}
+
+	repo, _ := t.orch.GetRepo(t.plan.Repo)
+	t.orch.hookExecutor.ExecuteHooks(ctx, repo.Config(), t.plan, t.linkSnapshot, []v1.Hook_Condition{
+		v1.Hook_CONDITION_FORGET_SUCCESS, v1.Hook_CONDITION_ANY_SUCCESS,
+	}, hook.HookVars{
+		Task:        t.Name(),
+		Forgotten:   forgotten,
+		OperationId: opId,
+	})
 	return nil
 }
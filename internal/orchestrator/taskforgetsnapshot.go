@@ -6,7 +6,7 @@ import (
 	"time"
 
 	v1 "github.com/garethgeorge/backrest/gen/go/v1"
-	"github.com/garethgeorge/backrest/internal/oplog/indexutil"
+	"github.com/garethgeorge/backrest/internal/oplog"
 	"github.com/hashicorp/go-multierror"
 	"go.uber.org/zap"
 )
@@ -66,7 +66,7 @@ func (t *ForgetSnapshotTask) Run(ctx context.Context) error {
 
 		// Find snapshot to forget
 		var ops []*v1.Operation
-		t.orch.OpLog.ForEachBySnapshotId(t.forgetSnapshot, indexutil.CollectAll(), func(op *v1.Operation) error {
+		t.orch.OpLog.Query(oplog.Query{SnapshotId: t.forgetSnapshot}, func(op *v1.Operation) error {
 			ops = append(ops, op)
 			return nil
 		})
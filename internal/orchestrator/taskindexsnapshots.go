@@ -9,7 +9,6 @@ import (
 	v1 "github.com/garethgeorge/backrest/gen/go/v1"
 	"github.com/garethgeorge/backrest/internal/hook"
 	"github.com/garethgeorge/backrest/internal/oplog"
-	"github.com/garethgeorge/backrest/internal/oplog/indexutil"
 	"github.com/garethgeorge/backrest/internal/protoutil"
 	"go.uber.org/zap"
 )
@@ -46,11 +45,12 @@ func (t *IndexSnapshotsTask) Next(now time.Time) *time.Time {
 func (t *IndexSnapshotsTask) Run(ctx context.Context) error {
 	if err := indexSnapshotsHelper(ctx, t.orchestrator, t.repoId); err != nil {
 		repo, _ := t.orchestrator.GetRepo(t.repoId)
-		t.orchestrator.hookExecutor.ExecuteHooks(repo.Config(), nil, "", []v1.Hook_Condition{
+		t.orchestrator.hookExecutor.ExecuteHooks(ctx, repo.Config(), nil, "", []v1.Hook_Condition{
 			v1.Hook_CONDITION_ANY_ERROR,
 		}, hook.HookVars{
-			Task:  t.Name(),
-			Error: err.Error(),
+			Task:      t.Name(),
+			Error:     err.Error(),
+			ErrorKind: errorKind(err),
 		})
 		return err
 	}
@@ -157,18 +157,20 @@ func indexSnapshotsHelper(ctx context.Context, orchestrator *Orchestrator, repoI
 }
 
 // returns a map of current (e.g. not forgotten) snapshot IDs for the plan.
-func indexCurrentSnapshotIdsForRepo(log *oplog.OpLog, repoId string) (map[string]int64, error) {
+func indexCurrentSnapshotIdsForRepo(log oplog.OpLog, repoId string) (map[string]int64, error) {
 	knownIds := make(map[string]int64)
 
 	startTime := time.Now()
-	if err := log.ForEachByRepo(repoId, indexutil.CollectAll(), func(op *v1.Operation) error {
-		if snapshotOp, ok := op.Op.(*v1.Operation_OperationIndexSnapshot); ok {
-			if snapshotOp.OperationIndexSnapshot == nil {
-				return fmt.Errorf("operation %q has nil OperationIndexSnapshot, this shouldn't be possible", op.Id)
-			}
-			if !snapshotOp.OperationIndexSnapshot.Forgot {
-				knownIds[snapshotOp.OperationIndexSnapshot.Snapshot.Id] = op.Id
-			}
+	if _, err := log.Query(oplog.Query{
+		RepoId: repoId,
+		Types:  []v1.OperationType{v1.OperationType_TYPE_INDEX_SNAPSHOT},
+	}, func(op *v1.Operation) error {
+		snapshotOp := op.GetOperationIndexSnapshot()
+		if snapshotOp == nil {
+			return fmt.Errorf("operation %q has nil OperationIndexSnapshot, this shouldn't be possible", op.Id)
+		}
+		if !snapshotOp.Forgot {
+			knownIds[snapshotOp.Snapshot.Id] = op.Id
 		}
 		return nil
 	}); err != nil {
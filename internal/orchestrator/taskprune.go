@@ -10,7 +10,8 @@ import (
 	v1 "github.com/garethgeorge/backrest/gen/go/v1"
 	"github.com/garethgeorge/backrest/internal/hook"
 	"github.com/garethgeorge/backrest/internal/oplog"
-	"github.com/garethgeorge/backrest/internal/oplog/indexutil"
+	"github.com/garethgeorge/backrest/internal/protoutil"
+	"github.com/garethgeorge/backrest/pkg/restic"
 	"go.uber.org/zap"
 )
 
@@ -85,13 +86,16 @@ func (t *PruneTask) shouldRun(now time.Time) (bool, error) {
 
 func (t *PruneTask) getNextPruneTime(repo *RepoOrchestrator, policy *v1.PrunePolicy) (time.Time, error) {
 	var lastPruneTime time.Time
-	t.orch.OpLog.ForEachByRepo(t.plan.Repo, indexutil.Reversed(indexutil.CollectAll()), func(op *v1.Operation) error {
-		if _, ok := op.Op.(*v1.Operation_OperationPrune); ok {
-			lastPruneTime = time.Unix(0, op.UnixTimeStartMs*int64(time.Millisecond))
-			return oplog.ErrStopIteration
-		}
-		return nil
-	})
+	if _, err := t.orch.OpLog.Query(oplog.Query{
+		RepoId:  t.plan.Repo,
+		Types:   []v1.OperationType{v1.OperationType_TYPE_PRUNE},
+		Reverse: true,
+	}, func(op *v1.Operation) error {
+		lastPruneTime = time.Unix(0, op.UnixTimeStartMs*int64(time.Millisecond))
+		return oplog.ErrStopIteration
+	}); err != nil {
+		return time.Time{}, fmt.Errorf("find last prune: %w", err)
+	}
 
 	if repo.repoConfig.PrunePolicy != nil {
 		return lastPruneTime.Add(time.Duration(repo.repoConfig.PrunePolicy.MaxFrequencyDays) * 24 * time.Hour), nil
@@ -101,22 +105,51 @@ func (t *PruneTask) getNextPruneTime(repo *RepoOrchestrator, policy *v1.PrunePol
 }
 
 func (t *PruneTask) Run(ctx context.Context) error {
+	var pruneStats *restic.PruneStats
+	skipped := false
+	var opId int64
+	cancelled := false
 	if err := t.runWithOpAndContext(ctx, func(ctx context.Context, op *v1.Operation) error {
+		opId = op.Id
 		repo, err := t.orch.GetRepo(t.plan.Repo)
 		if err != nil {
 			return fmt.Errorf("get repo %v: %w", t.plan.Repo, err)
 		}
 
-		err = repo.UnlockIfAutoEnabled(ctx)
-		if err != nil {
-			return fmt.Errorf("auto unlock repo %q: %w", t.plan.Repo, err)
-		}
-
 		opPrune := &v1.Operation_OperationPrune{
 			OperationPrune: &v1.OperationPrune{},
 		}
 		op.Op = opPrune
 
+		// measure the unused space with a dry run first, repacking is expensive and is skipped if the prune policy's limits aren't exceeded.
+		if !t.force {
+			preview, err := repo.PruneDryRun(ctx)
+			if err != nil {
+				return fmt.Errorf("prune dry run: %w", err)
+			}
+			if !unusedSpaceExceedsPolicy(repo.PrunePolicy(), preview) {
+				opPrune.OperationPrune.Stats = protoutil.PruneStatsToProto(preview)
+				opPrune.OperationPrune.Skipped = true
+				op.DisplayMessage = fmt.Sprintf("Prune skipped, %d bytes (%.2f%%) unused is within the repo's prune policy.", preview.BytesUnused, preview.UnusedPercent())
+				pruneStats = preview
+				skipped = true
+				return nil
+			}
+		}
+
+		if err := t.orch.hookExecutor.ExecuteHooks(ctx, repo.Config(), t.plan, "", []v1.Hook_Condition{
+			v1.Hook_CONDITION_PRUNE_START,
+		}, hook.HookVars{
+			Task:        t.Name(),
+			OperationId: opId,
+		}); err != nil {
+			if skippedByHook(err, op, "Prune") {
+				cancelled = true
+				return nil
+			}
+			return fmt.Errorf("prune start hook: %w", err)
+		}
+
 		ctx, cancel := context.WithCancel(ctx)
 		interval := time.NewTicker(1 * time.Second)
 		defer interval.Stop()
@@ -147,7 +180,8 @@ func (t *PruneTask) Run(ctx context.Context) error {
 			}
 		}()
 
-		if err := repo.Prune(ctx, &buf); err != nil {
+		stats, err := repo.Prune(ctx, &buf)
+		if err != nil {
 			cancel()
 			return fmt.Errorf("prune: %w", err)
 		}
@@ -162,26 +196,60 @@ func (t *PruneTask) Run(ctx context.Context) error {
 		op.Op = &v1.Operation_OperationPrune{
 			OperationPrune: &v1.OperationPrune{
 				Output: output,
+				Stats:  protoutil.PruneStatsToProto(stats),
 			},
 		}
+		pruneStats = stats
 
 		return nil
 	}); err != nil {
 		repo, _ := t.orch.GetRepo(t.plan.Repo)
-		t.orch.hookExecutor.ExecuteHooks(repo.Config(), t.plan, "", []v1.Hook_Condition{
-			v1.Hook_CONDITION_ANY_ERROR,
+		t.orch.hookExecutor.ExecuteHooks(ctx, repo.Config(), t.plan, "", []v1.Hook_Condition{
+			v1.Hook_CONDITION_PRUNE_ERROR, v1.Hook_CONDITION_ANY_ERROR,
 		}, hook.HookVars{
-			Task:  t.Name(),
-			Error: err.Error(),
+			Task:        t.Name(),
+			Error:       err.Error(),
+			ErrorKind:   errorKind(err),
+			OperationId: opId,
 		})
 		return err
 	}
 
-	t.orch.ScheduleTask(NewOneoffStatsTask(t.orch, t.plan.Repo, t.plan.Id, time.Now()), TaskPriorityStats)
+	// a task skipped by its start hooks doesn't run its success hooks.
+	if cancelled {
+		return nil
+	}
+
+	// a prune skipped by the prune policy never started, so it only counts towards ANY_SUCCESS.
+	conditions := []v1.Hook_Condition{v1.Hook_CONDITION_PRUNE_SUCCESS, v1.Hook_CONDITION_ANY_SUCCESS}
+	if skipped {
+		conditions = []v1.Hook_Condition{v1.Hook_CONDITION_ANY_SUCCESS}
+	}
+	repo, _ := t.orch.GetRepo(t.plan.Repo)
+	t.orch.hookExecutor.ExecuteHooks(ctx, repo.Config(), t.plan, "", conditions, hook.HookVars{
+		Task:        t.Name(),
+		PruneStats:  pruneStats,
+		OperationId: opId,
+	})
+
+	if !skipped {
+		t.orch.ScheduleTask(NewOneoffStatsTask(t.orch, t.plan.Repo, t.plan.Id, time.Now()), TaskPriorityStats)
+	}
 
 	return nil
 }
 
+// unusedSpaceExceedsPolicy returns true if the unused space measured by a prune dry run exceeds the limits of the prune policy.
+// A policy without limits is always exceeded.
+func unusedSpaceExceedsPolicy(policy *v1.PrunePolicy, stats *restic.PruneStats) bool {
+	if policy.GetMaxUnusedBytes() != 0 {
+		return stats.BytesUnused > int64(policy.GetMaxUnusedBytes())
+	} else if policy.GetMaxUnusedPercent() != 0 {
+		return stats.UnusedPercent() > float64(policy.GetMaxUnusedPercent())
+	}
+	return true
+}
+
 // synchronizedBuffer is used for collecting prune command's output
 type synchronizedBuffer struct {
 	mu  sync.Mutex
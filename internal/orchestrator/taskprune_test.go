@@ -0,0 +1,113 @@
+package orchestrator
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+	"github.com/garethgeorge/backrest/internal/oplog"
+	"github.com/garethgeorge/backrest/pkg/restic"
+)
+
+func TestUnusedSpaceExceedsPolicy(t *testing.T) {
+	t.Parallel()
+
+	stats := &restic.PruneStats{
+		BytesTotal:  1000,
+		BytesUnused: 200,
+	}
+
+	tests := []struct {
+		name   string
+		policy *v1.PrunePolicy
+		want   bool
+	}{
+		{
+			name:   "no limits",
+			policy: &v1.PrunePolicy{},
+			want:   true,
+		},
+		{
+			name:   "below percent limit",
+			policy: &v1.PrunePolicy{MaxUnusedPercent: 25},
+			want:   false,
+		},
+		{
+			name:   "above percent limit",
+			policy: &v1.PrunePolicy{MaxUnusedPercent: 10},
+			want:   true,
+		},
+		{
+			name:   "below bytes limit",
+			policy: &v1.PrunePolicy{MaxUnusedBytes: 500},
+			want:   false,
+		},
+		{
+			name:   "above bytes limit",
+			policy: &v1.PrunePolicy{MaxUnusedBytes: 100},
+			want:   true,
+		},
+		{
+			name:   "bytes limit takes precedence over percent limit",
+			policy: &v1.PrunePolicy{MaxUnusedBytes: 500, MaxUnusedPercent: 10},
+			want:   false,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			if got := unusedSpaceExceedsPolicy(tc.policy, stats); got != tc.want {
+				t.Errorf("unusedSpaceExceedsPolicy() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestPruneSuccessHookVars(t *testing.T) {
+	t.Parallel()
+
+	script := `cat <<EOF
+used:                 16 blobs / 38.003 KiB
+unused:               74 blobs / 1.072 MiB
+total:                90 blobs / 1.109 MiB
+unused size: 96.65% of total size
+
+to repack:            69 blobs / 1.078 MiB
+this removes:         67 blobs / 1.047 MiB
+to delete:             7 blobs / 25.726 KiB
+total prune:          74 blobs / 1.072 MiB
+remaining:            16 blobs / 38.003 KiB
+unused size after prune: 512 B (1.32% of remaining size)
+done
+EOF`
+
+	outFile := filepath.Join(t.TempDir(), "out.txt")
+	cfg := &v1.Config{
+		Repos: []*v1.Repo{
+			{Id: "repo1", Uri: t.TempDir(), Password: "test"},
+		},
+		Plans: []*v1.Plan{
+			{Id: "plan1", Repo: "repo1", Cron: "0 0 1 1 *", Hooks: []*v1.Hook{{
+				Conditions: []v1.Hook_Condition{v1.Hook_CONDITION_PRUNE_SUCCESS},
+				Action:     &v1.Hook_ActionCommand{ActionCommand: &v1.Hook_Command{Command: "echo {{ .PruneStats.BytesRemoved }} > " + outFile}},
+			}}},
+		},
+	}
+
+	if err := runTestTask(t, script, cfg, oplog.NewMemStore(), func(orch *Orchestrator) Task {
+		return NewOneoffPruneTask(orch, cfg.Plans[0], time.Now(), true)
+	}); err != nil {
+		t.Fatalf("prune failed: %v", err)
+	}
+
+	out, err := os.ReadFile(outFile)
+	if err != nil {
+		t.Fatalf("failed to read hook output: %v", err)
+	}
+	if want := "1124073\n"; string(out) != want {
+		t.Errorf("want hook output %q, got %q", want, string(out))
+	}
+}
@@ -66,7 +66,11 @@ func (t *RestoreTask) Run(ctx context.Context) error {
 		return errors.New("snapshotId, path, and target are required")
 	}
 
+	var restoreStats *v1.RestoreProgressEntry
+	var opId int64
+	cancelled := false
 	if err := t.runWithOpAndContext(ctx, func(ctx context.Context, op *v1.Operation) error {
+		opId = op.Id
 		forgetOp := &v1.Operation_OperationRestore{
 			OperationRestore: &v1.OperationRestore{
 				Path:   t.restoreOpts.Path,
@@ -81,6 +85,21 @@ func (t *RestoreTask) Run(ctx context.Context) error {
 			return fmt.Errorf("couldn't get repo %q: %w", t.restoreOpts.RepoId, err)
 		}
 
+		if err := t.orch.hookExecutor.ExecuteHooks(ctx, repo.Config(), t.plan(), t.restoreOpts.SnapshotId, []v1.Hook_Condition{
+			v1.Hook_CONDITION_RESTORE_START,
+		}, hook.HookVars{
+			Task:          t.Name(),
+			RestorePath:   t.restoreOpts.Path,
+			RestoreTarget: t.restoreOpts.Target,
+			OperationId:   opId,
+		}); err != nil {
+			if skippedByHook(err, op, "Restore") {
+				cancelled = true
+				return nil
+			}
+			return fmt.Errorf("restore start hook: %w", err)
+		}
+
 		lastSent := time.Now() // debounce progress updates, these can endup being very frequent.
 		summary, err := repo.Restore(ctx, t.restoreOpts.SnapshotId, t.restoreOpts.Path, t.restoreOpts.Target, func(entry *v1.RestoreProgressEntry) {
 			if time.Since(lastSent) < 250*time.Millisecond {
@@ -90,7 +109,7 @@ func (t *RestoreTask) Run(ctx context.Context) error {
 
 			zap.S().Infof("restore progress: %v", entry)
 			forgetOp.OperationRestore.Status = entry
-			if err := t.orch.OpLog.Update(op); err != nil {
+			if err := t.orch.OpLog.UpdateProgress(op); err != nil {
 				zap.S().Errorf("failed to update oplog with progress for restore: %v", err)
 			}
 		})
@@ -98,19 +117,50 @@ func (t *RestoreTask) Run(ctx context.Context) error {
 			return fmt.Errorf("restore failed: %w", err)
 		}
 		forgetOp.OperationRestore.Status = summary
+		restoreStats = summary
 
 		return nil
 	}); err != nil {
 		if t.restoreOpts.RepoId != "" {
 			repo, _ := t.orch.GetRepo(t.restoreOpts.RepoId)
-			t.orch.hookExecutor.ExecuteHooks(repo.Config(), nil, t.restoreOpts.SnapshotId, []v1.Hook_Condition{
-				v1.Hook_CONDITION_ANY_ERROR,
+			t.orch.hookExecutor.ExecuteHooks(ctx, repo.Config(), t.plan(), t.restoreOpts.SnapshotId, []v1.Hook_Condition{
+				v1.Hook_CONDITION_RESTORE_ERROR, v1.Hook_CONDITION_ANY_ERROR,
 			}, hook.HookVars{
-				Task:  t.Name(),
-				Error: err.Error(),
+				Task:          t.Name(),
+				Error:         err.Error(),
+				ErrorKind:     errorKind(err),
+				RestorePath:   t.restoreOpts.Path,
+				RestoreTarget: t.restoreOpts.Target,
+				OperationId:   opId,
 			})
 		}
 		return err
 	}
+
+	// a task skipped by its start hooks doesn't run its success hooks.
+	if cancelled {
+		return nil
+	}
+
+	repo, _ := t.orch.GetRepo(t.restoreOpts.RepoId)
+	t.orch.hookExecutor.ExecuteHooks(ctx, repo.Config(), t.plan(), t.restoreOpts.SnapshotId, []v1.Hook_Condition{
+		v1.Hook_CONDITION_RESTORE_SUCCESS, v1.Hook_CONDITION_ANY_SUCCESS,
+	}, hook.HookVars{
+		Task:          t.Name(),
+		RestorePath:   t.restoreOpts.Path,
+		RestoreTarget: t.restoreOpts.Target,
+		RestoreStats:  restoreStats,
+		OperationId:   opId,
+	})
 	return nil
 }
+
+// plan returns the plan the restored snapshot belongs to, its hooks run for the restore and its id is recorded on the hooks' operations.
+// A plan removed from the config since the snapshot was taken has no hooks but still names the operations.
+func (t *RestoreTask) plan() *v1.Plan {
+	plan, err := t.orch.GetPlan(t.restoreOpts.PlanId)
+	if err != nil {
+		return &v1.Plan{Id: t.restoreOpts.PlanId}
+	}
+	return plan
+}
@@ -0,0 +1,129 @@
+package orchestrator
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+	"github.com/garethgeorge/backrest/internal/oplog"
+)
+
+func TestRestoreHooks(t *testing.T) {
+	t.Parallel()
+
+	// restic fails the restore so that both the start and the error hooks run.
+	script := `echo "Fatal: no matching ID found for prefix" >&2; exit 1`
+
+	hook := func(condition v1.Hook_Condition) *v1.Hook {
+		return &v1.Hook{
+			Conditions: []v1.Hook_Condition{condition},
+			Action:     &v1.Hook_ActionCommand{ActionCommand: &v1.Hook_Command{Command: "true"}},
+		}
+	}
+	cfg := &v1.Config{
+		Repos: []*v1.Repo{
+			{Id: "repo1", Uri: t.TempDir(), Password: "test", Hooks: []*v1.Hook{hook(v1.Hook_CONDITION_RESTORE_START)}},
+		},
+		Plans: []*v1.Plan{
+			{Id: "plan1", Repo: "repo1", Cron: "0 0 1 1 *", Hooks: []*v1.Hook{hook(v1.Hook_CONDITION_RESTORE_ERROR)}},
+		},
+	}
+
+	log := oplog.NewMemStore()
+	err := runTestTask(t, script, cfg, log, func(orch *Orchestrator) Task {
+		return NewOneoffRestoreTask(orch, RestoreTaskOpts{
+			RepoId:     "repo1",
+			PlanId:     "plan1",
+			SnapshotId: strings.Repeat("a", 64),
+			Path:       "/",
+			Target:     t.TempDir(),
+		}, time.Now())
+	})
+	if err == nil {
+		t.Fatalf("expected the restore to fail")
+	}
+
+	var ran []v1.Hook_Condition
+	if _, err := log.Query(oplog.Query{PlanId: "plan1", Types: []v1.OperationType{v1.OperationType_TYPE_RUN_HOOK}}, func(op *v1.Operation) error {
+		if op.Status != v1.OperationStatus_STATUS_SUCCESS {
+			t.Errorf("hook %v status = %v, want success", op.GetOperationRunHook().GetName(), op.Status)
+		}
+		ran = append(ran, op.GetOperationRunHook().GetCondition())
+		return nil
+	}); err != nil {
+		t.Fatalf("failed to query operations: %v", err)
+	}
+	if want := []v1.Hook_Condition{v1.Hook_CONDITION_RESTORE_START, v1.Hook_CONDITION_RESTORE_ERROR}; !slices.Equal(ran, want) {
+		t.Errorf("ran hooks for %v, want %v", ran, want)
+	}
+}
+
+func TestRestoreStartHookError(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		onError    v1.Hook_OnError
+		wantErr    bool
+		wantStatus v1.OperationStatus
+	}{
+		{name: "fatal", onError: v1.Hook_ON_ERROR_FATAL, wantErr: true, wantStatus: v1.OperationStatus_STATUS_ERROR},
+		{name: "cancel", onError: v1.Hook_ON_ERROR_CANCEL, wantStatus: v1.OperationStatus_STATUS_SYSTEM_CANCELLED},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			// restic records that it ran, the restore must not start after its start hook fails.
+			marker := filepath.Join(t.TempDir(), "ran")
+			script := "touch " + marker
+
+			cfg := &v1.Config{
+				Repos: []*v1.Repo{
+					{Id: "repo1", Uri: t.TempDir(), Password: "test", Hooks: []*v1.Hook{{
+						Conditions: []v1.Hook_Condition{v1.Hook_CONDITION_RESTORE_START},
+						Action:     &v1.Hook_ActionCommand{ActionCommand: &v1.Hook_Command{Command: "exit 1"}},
+						OnError:    tc.onError,
+					}}},
+				},
+				Plans: []*v1.Plan{
+					{Id: "plan1", Repo: "repo1", Cron: "0 0 1 1 *"},
+				},
+			}
+
+			log := oplog.NewMemStore()
+			err := runTestTask(t, script, cfg, log, func(orch *Orchestrator) Task {
+				return NewOneoffRestoreTask(orch, RestoreTaskOpts{
+					RepoId:     "repo1",
+					PlanId:     "plan1",
+					SnapshotId: strings.Repeat("a", 64),
+					Path:       "/",
+					Target:     t.TempDir(),
+				}, time.Now())
+			})
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("restore error = %v, want error: %v", err, tc.wantErr)
+			}
+
+			var statuses []v1.OperationStatus
+			if _, err := log.Query(oplog.Query{PlanId: "plan1", Types: []v1.OperationType{v1.OperationType_TYPE_RESTORE}}, func(op *v1.Operation) error {
+				statuses = append(statuses, op.Status)
+				return nil
+			}); err != nil {
+				t.Fatalf("failed to query operations: %v", err)
+			}
+			if want := []v1.OperationStatus{tc.wantStatus}; !slices.Equal(statuses, want) {
+				t.Errorf("restore statuses = %v, want %v", statuses, want)
+			}
+			if _, err := os.Stat(marker); err == nil {
+				t.Errorf("restic ran after the start hook failed")
+			}
+		})
+	}
+}
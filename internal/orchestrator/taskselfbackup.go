@@ -0,0 +1,57 @@
+package orchestrator
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path"
+
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+	"github.com/garethgeorge/backrest/internal/config"
+	"github.com/garethgeorge/backrest/internal/selfbackup"
+	"go.uber.org/zap"
+)
+
+// SelfBackupTask backs up backrest's own state to the self backup repo. The state is staged in the data dir, the operation log
+// as a consistent snapshot, and the staging dir is backed up as the reserved plan config.PlanForSelfBackup.
+type SelfBackupTask struct {
+	*BackupTask
+	dataDir    string
+	configPath string
+}
+
+var _ Task = &SelfBackupTask{}
+
+func NewScheduledSelfBackupTask(orchestrator *Orchestrator, cfg *v1.SelfBackup, dataDir, configPath string) (*SelfBackupTask, error) {
+	t, err := NewScheduledBackupTask(orchestrator, &v1.Plan{
+		Id:    config.PlanForSelfBackup,
+		Repo:  cfg.Repo,
+		Paths: []string{path.Join(dataDir, "selfbackup")},
+		Cron:  cfg.Cron,
+	})
+	if err != nil {
+		return nil, err
+	}
+	t.name = "self backup"
+	return &SelfBackupTask{
+		BackupTask: t,
+		dataDir:    dataDir,
+		configPath: configPath,
+	}, nil
+}
+
+func (t *SelfBackupTask) Run(ctx context.Context) error {
+	return t.runWithOpAndContext(ctx, func(ctx context.Context, op *v1.Operation) error {
+		stagingDir := t.plan.Paths[0]
+		// the staged copies include the auth secret, they're only kept for as long as the backup needs them.
+		defer func() {
+			if err := os.RemoveAll(stagingDir); err != nil {
+				zap.S().Warnf("failed to remove self backup staging dir %q: %v", stagingDir, err)
+			}
+		}()
+		if err := selfbackup.Stage(t.orch.OpLog, t.dataDir, t.configPath, stagingDir); err != nil {
+			return fmt.Errorf("stage backrest state: %w", err)
+		}
+		return backupHelper(ctx, t, t.orch, t.plan, op)
+	})
+}
@@ -0,0 +1,56 @@
+package orchestrator
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+	"github.com/garethgeorge/backrest/internal/oplog"
+	"github.com/garethgeorge/backrest/internal/selfbackup"
+)
+
+func TestSelfBackupRemovesStagedFiles(t *testing.T) {
+	t.Parallel()
+
+	// restic lists the staging dir, the absolute path among the backup's arguments, into stagedList before failing the backup.
+	stagedList := filepath.Join(t.TempDir(), "staged")
+	script := `case "$1" in
+snapshots) echo "[]" ;;
+backup) for arg; do case "$arg" in /*) dir=$arg ;; esac; done; ls "$dir" > "` + stagedList + `"; exit 1 ;;
+esac`
+
+	dataDir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dataDir, selfbackup.SecretFile), []byte("secret"), 0600); err != nil {
+		t.Fatalf("failed to write secret: %v", err)
+	}
+	cfg := &v1.Config{
+		Repos: []*v1.Repo{{Id: "repo1", Uri: t.TempDir(), Password: "test"}},
+	}
+	log, err := oplog.NewBoltStore(filepath.Join(dataDir, "oplog.boltdb"))
+	if err != nil {
+		t.Fatalf("failed to create oplog: %v", err)
+	}
+	t.Cleanup(func() { log.Close() })
+	if err := runTestTask(t, script, cfg, log, func(orch *Orchestrator) Task {
+		task, err := NewScheduledSelfBackupTask(orch, &v1.SelfBackup{Repo: "repo1", Cron: "0 0 1 1 *"}, dataDir, filepath.Join(t.TempDir(), "config.json"))
+		if err != nil {
+			t.Fatalf("failed to create self backup task: %v", err)
+		}
+		return task
+	}); err == nil {
+		t.Fatalf("expected the self backup to fail")
+	}
+
+	staged, err := os.ReadFile(stagedList)
+	if err != nil {
+		t.Fatalf("failed to read the files staged for the backup: %v", err)
+	}
+	if !strings.Contains(string(staged), selfbackup.SecretFile) {
+		t.Errorf("expected the secret to be staged for the backup, got %q", staged)
+	}
+	if _, err := os.Stat(filepath.Join(dataDir, "selfbackup")); !os.IsNotExist(err) {
+		t.Errorf("expected the staging dir to be removed after the backup, got %v", err)
+	}
+}
@@ -55,16 +55,35 @@ func (t *StatsTask) Next(now time.Time) *time.Time {
 }
 
 func (t *StatsTask) Run(ctx context.Context) error {
+	var repoStats *v1.RepoStats
+	var opId int64
+	cancelled := false
 	if err := t.runWithOpAndContext(ctx, func(ctx context.Context, op *v1.Operation) error {
+		opId = op.Id
 		repo, err := t.orch.GetRepo(t.repoId)
 		if err != nil {
 			return fmt.Errorf("get repo %q: %w", t.repoId, err)
 		}
 
+		plan, _ := t.orch.GetPlan(t.planId)
+		if err := t.orch.hookExecutor.ExecuteHooks(ctx, repo.Config(), plan, "", []v1.Hook_Condition{
+			v1.Hook_CONDITION_STATS_START,
+		}, hook.HookVars{
+			Task:        t.Name(),
+			OperationId: opId,
+		}); err != nil {
+			if skippedByHook(err, op, "Stats") {
+				cancelled = true
+				return nil
+			}
+			return fmt.Errorf("stats start hook: %w", err)
+		}
+
 		stats, err := repo.Stats(ctx)
 		if err != nil {
 			return fmt.Errorf("get stats: %w", err)
 		}
+		repoStats = stats
 
 		op.Op = &v1.Operation_OperationStats{
 			OperationStats: &v1.OperationStats{
@@ -76,13 +95,30 @@ func (t *StatsTask) Run(ctx context.Context) error {
 	}); err != nil {
 		repo, _ := t.orch.GetRepo(t.repoId)
 		plan, _ := t.orch.GetPlan(t.planId)
-		t.orch.hookExecutor.ExecuteHooks(repo.Config(), plan, "", []v1.Hook_Condition{
-			v1.Hook_CONDITION_ANY_ERROR,
+		t.orch.hookExecutor.ExecuteHooks(ctx, repo.Config(), plan, "", []v1.Hook_Condition{
+			v1.Hook_CONDITION_STATS_ERROR, v1.Hook_CONDITION_ANY_ERROR,
 		}, hook.HookVars{
-			Task:  t.Name(),
-			Error: err.Error(),
+			Task:        t.Name(),
+			Error:       err.Error(),
+			ErrorKind:   errorKind(err),
+			OperationId: opId,
 		})
 		return err
 	}
+
+	// a task skipped by its start hooks doesn't run its success hooks.
+	if cancelled {
+		return nil
+	}
+
+	repo, _ := t.orch.GetRepo(t.repoId)
+	plan, _ := t.orch.GetPlan(t.planId)
+	t.orch.hookExecutor.ExecuteHooks(ctx, repo.Config(), plan, "", []v1.Hook_Condition{
+		v1.Hook_CONDITION_STATS_SUCCESS, v1.Hook_CONDITION_ANY_SUCCESS,
+	}, hook.HookVars{
+		Task:        t.Name(),
+		RepoStats:   repoStats,
+		OperationId: opId,
+	})
 	return nil
 }
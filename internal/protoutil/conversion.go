@@ -5,6 +5,7 @@ import (
 
 	v1 "github.com/garethgeorge/backrest/gen/go/v1"
 	"github.com/garethgeorge/backrest/pkg/restic"
+	"github.com/garethgeorge/backrest/pkg/resticrepo"
 )
 
 func SnapshotToProto(s *restic.Snapshot) *v1.ResticSnapshot {
@@ -35,6 +36,22 @@ func LsEntryToProto(e *restic.LsEntry) *v1.LsEntry {
 	}
 }
 
+// NodeToProto converts a node read natively from a repo to the entry `restic ls` would print for it.
+func NodeToProto(n *resticrepo.Node) *v1.LsEntry {
+	return &v1.LsEntry{
+		Name:  n.Name,
+		Type:  n.Type,
+		Path:  n.Path,
+		Uid:   int64(n.UID),
+		Gid:   int64(n.GID),
+		Size:  int64(n.Size),
+		Mode:  int64(n.Mode),
+		Mtime: n.ModTime,
+		Atime: n.AccessTime,
+		Ctime: n.ChangeTime,
+	}
+}
+
 func BackupProgressEntryToProto(b *restic.BackupProgressEntry) *v1.BackupProgressEntry {
 	switch b.MessageType {
 	case "summary":
@@ -75,6 +92,26 @@ func BackupProgressEntryToProto(b *restic.BackupProgressEntry) *v1.BackupProgres
 	}
 }
 
+// BackupProgressSummaryFromProto converts a backup summary back to the summary entry reported by restic.
+func BackupProgressSummaryFromProto(s *v1.BackupProgressSummary) *restic.BackupProgressEntry {
+	return &restic.BackupProgressEntry{
+		MessageType:         "summary",
+		FilesNew:            int(s.FilesNew),
+		FilesChanged:        int(s.FilesChanged),
+		FilesUnmodified:     int(s.FilesUnmodified),
+		DirsNew:             int(s.DirsNew),
+		DirsChanged:         int(s.DirsChanged),
+		DirsUnmodified:      int(s.DirsUnmodified),
+		DataBlobs:           int(s.DataBlobs),
+		TreeBlobs:           int(s.TreeBlobs),
+		DataAdded:           int(s.DataAdded),
+		TotalFilesProcessed: int(s.TotalFilesProcessed),
+		TotalBytesProcessed: int(s.TotalBytesProcessed),
+		TotalDuration:       s.TotalDuration,
+		SnapshotId:          s.SnapshotId,
+	}
+}
+
 // BackupProgressEntryToBackupError converts a BackupProgressEntry to a BackupError if it's type is "error"
 func BackupProgressEntryToBackupError(b *restic.BackupProgressEntry) (*v1.BackupProgressError, error) {
 	if b.MessageType != "error" {
@@ -150,3 +187,52 @@ func RepoStatsToProto(s *restic.RepoStats) *v1.RepoStats {
 		SnapshotCount:         int64(s.SnapshotsCount),
 	}
 }
+
+func PruneStatsToProto(s *restic.PruneStats) *v1.PruneStats {
+	return &v1.PruneStats{
+		BlobsTotal:         s.BlobsTotal,
+		BytesTotal:         s.BytesTotal,
+		BlobsUnused:        s.BlobsUnused,
+		BytesUnused:        s.BytesUnused,
+		BlobsRepacked:      s.BlobsRepacked,
+		BytesRepacked:      s.BytesRepacked,
+		BlobsRemoved:       s.BlobsRemoved,
+		BytesRemoved:       s.BytesRemoved,
+		BlobsRemaining:     s.BlobsRemaining,
+		BytesRemaining:     s.BytesRemaining,
+		BytesUnusedAfter:   s.BytesUnusedAfter,
+		PercentUnusedAfter: s.PercentUnusedAfter,
+		PacksRepacked:      s.PacksRepacked,
+		PacksRemoved:       s.PacksRemoved,
+	}
+}
+
+func PruneStatsFromProto(s *v1.PruneStats) *restic.PruneStats {
+	return &restic.PruneStats{
+		BlobsTotal:         s.BlobsTotal,
+		BytesTotal:         s.BytesTotal,
+		BlobsUnused:        s.BlobsUnused,
+		BytesUnused:        s.BytesUnused,
+		BlobsRepacked:      s.BlobsRepacked,
+		BytesRepacked:      s.BytesRepacked,
+		BlobsRemoved:       s.BlobsRemoved,
+		BytesRemoved:       s.BytesRemoved,
+		BlobsRemaining:     s.BlobsRemaining,
+		BytesRemaining:     s.BytesRemaining,
+		BytesUnusedAfter:   s.BytesUnusedAfter,
+		PercentUnusedAfter: s.PercentUnusedAfter,
+		PacksRepacked:      s.PacksRepacked,
+		PacksRemoved:       s.PacksRemoved,
+	}
+}
+
+func LockToProto(l *restic.Lock) *v1.ResticLock {
+	return &v1.ResticLock{
+		Id:         l.Id,
+		UnixTimeMs: l.Time.UnixMilli(),
+		Exclusive:  l.Exclusive,
+		Hostname:   l.Hostname,
+		Username:   l.Username,
+		Pid:        int64(l.Pid),
+	}
+}
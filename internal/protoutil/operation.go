@@ -0,0 +1,25 @@
+package protoutil
+
+import v1 "github.com/garethgeorge/backrest/gen/go/v1"
+
+// OperationType returns the type of the operation i.e. which field of op.Op is set.
+func OperationType(op *v1.Operation) v1.OperationType {
+	switch op.Op.(type) {
+	case *v1.Operation_OperationBackup:
+		return v1.OperationType_TYPE_BACKUP
+	case *v1.Operation_OperationIndexSnapshot:
+		return v1.OperationType_TYPE_INDEX_SNAPSHOT
+	case *v1.Operation_OperationForget:
+		return v1.OperationType_TYPE_FORGET
+	case *v1.Operation_OperationPrune:
+		return v1.OperationType_TYPE_PRUNE
+	case *v1.Operation_OperationRestore:
+		return v1.OperationType_TYPE_RESTORE
+	case *v1.Operation_OperationStats:
+		return v1.OperationType_TYPE_STATS
+	case *v1.Operation_OperationRunHook:
+		return v1.OperationType_TYPE_RUN_HOOK
+	default:
+		return v1.OperationType_TYPE_UNKNOWN
+	}
+}
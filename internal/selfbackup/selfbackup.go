@@ -0,0 +1,163 @@
+// Package selfbackup stages and installs copies of backrest's own state: the operation log, the config, the auth secret and the logs.
+package selfbackup
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"time"
+
+	"github.com/garethgeorge/backrest/internal/oplog"
+	"go.uber.org/zap"
+)
+
+// names of the files in a staged self backup, the operation log is staged under the name of the file of its store.
+const (
+	OplogFile       = "oplog.boltdb"
+	SqliteOplogFile = "oplog.sqlite"
+	ConfigFile      = "config.json"
+	SecretFile      = "jwt-secret"
+	RotatingLogs    = "rotatinglogs"
+)
+
+// snapshotter is implemented by the oplog stores that can write a consistent copy of themselves while in use.
+type snapshotter interface {
+	WriteSnapshot(w io.Writer) (int64, error)
+}
+
+// Stage writes a consistent snapshot of the operation log and copies of the config, auth secret and logs to stagingDir, replacing
+// whatever was staged before. Files that don't exist yet e.g. the config of a fresh install are skipped.
+func Stage(log oplog.OpLog, dataDir, configPath, stagingDir string) error {
+	var oplogFile string
+	switch log.(type) {
+	case *oplog.BoltStore:
+		oplogFile = OplogFile
+	case *oplog.SqliteStore:
+		oplogFile = SqliteOplogFile
+	}
+	store, ok := log.(snapshotter)
+	if !ok || oplogFile == "" {
+		return fmt.Errorf("self backup does not support the oplog store %T", log)
+	}
+	if err := os.RemoveAll(stagingDir); err != nil {
+		return fmt.Errorf("remove previous staging dir: %w", err)
+	}
+	if err := os.MkdirAll(stagingDir, 0700); err != nil {
+		return fmt.Errorf("create staging dir: %w", err)
+	}
+
+	f, err := os.OpenFile(path.Join(stagingDir, oplogFile), os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600)
+	if err != nil {
+		return fmt.Errorf("create oplog snapshot: %w", err)
+	}
+	if _, err := store.WriteSnapshot(f); err != nil {
+		f.Close()
+		return fmt.Errorf("write oplog snapshot: %w", err)
+	}
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("close oplog snapshot: %w", err)
+	}
+
+	if err := copyIfExists(configPath, path.Join(stagingDir, ConfigFile)); err != nil {
+		return fmt.Errorf("copy config: %w", err)
+	}
+	if err := copyIfExists(path.Join(dataDir, SecretFile), path.Join(stagingDir, SecretFile)); err != nil {
+		return fmt.Errorf("copy auth secret: %w", err)
+	}
+	if err := copyIfExists(path.Join(dataDir, RotatingLogs), path.Join(stagingDir, RotatingLogs)); err != nil {
+		return fmt.Errorf("copy logs: %w", err)
+	}
+	return nil
+}
+
+// Install rebuilds the data dir and config from a staged self backup in srcDir. The operation log is opened first, which checks its
+// integrity, so a damaged snapshot doesn't replace the current state. It's installed for the store it was staged from, backrest must
+// be started with that store to use it. Existing files are kept next to their replacement with a .bak-<unix time> suffix. Backrest
+// must not be running.
+func Install(srcDir, dataDir, configPath string) error {
+	var log oplog.OpLog
+	var err error
+	if _, statErr := os.Stat(path.Join(srcDir, SqliteOplogFile)); statErr == nil {
+		log, err = oplog.NewSqliteStore(path.Join(srcDir, SqliteOplogFile))
+	} else {
+		log, err = oplog.NewBoltStore(path.Join(srcDir, OplogFile))
+	}
+	if err != nil {
+		return fmt.Errorf("open restored oplog: %w", err)
+	} else if err := log.Close(); err != nil {
+		return fmt.Errorf("close restored oplog: %w", err)
+	}
+
+	if err := os.MkdirAll(dataDir, 0700); err != nil {
+		return fmt.Errorf("create data dir: %w", err)
+	}
+	suffix := fmt.Sprintf(".bak-%d", time.Now().Unix())
+	targets := []struct{ src, dst string }{
+		{path.Join(srcDir, OplogFile), path.Join(dataDir, OplogFile)},
+		{path.Join(srcDir, SqliteOplogFile), path.Join(dataDir, SqliteOplogFile)},
+		{path.Join(srcDir, ConfigFile), configPath},
+		{path.Join(srcDir, SecretFile), path.Join(dataDir, SecretFile)},
+		{path.Join(srcDir, RotatingLogs), path.Join(dataDir, RotatingLogs)},
+	}
+	for _, t := range targets {
+		if _, err := os.Stat(t.src); errors.Is(err, os.ErrNotExist) {
+			continue // not part of the backup e.g. it was taken before the config was first saved.
+		}
+		if _, err := os.Stat(t.dst); err == nil {
+			if err := os.Rename(t.dst, t.dst+suffix); err != nil {
+				return fmt.Errorf("move aside %q: %w", t.dst, err)
+			}
+			zap.S().Infof("moved existing %q to %q", t.dst, t.dst+suffix)
+		}
+		if err := os.MkdirAll(path.Dir(t.dst), 0700); err != nil {
+			return fmt.Errorf("create dir for %q: %w", t.dst, err)
+		}
+		if err := copyIfExists(t.src, t.dst); err != nil {
+			return fmt.Errorf("install %q: %w", t.dst, err)
+		}
+	}
+	return nil
+}
+
+// copyIfExists copies the file or directory tree at src to dst, doing nothing if src doesn't exist.
+func copyIfExists(src, dst string) error {
+	if _, err := os.Stat(src); errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	return filepath.WalkDir(src, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(src, p)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dst, rel)
+		if d.IsDir() {
+			return os.MkdirAll(target, 0700)
+		}
+		return copyFile(p, target)
+	})
+}
+
+func copyFile(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	out, err := os.OpenFile(dst, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		return err
+	}
+	return out.Close()
+}
@@ -0,0 +1,9 @@
+//go:build cgo
+
+package selfbackup
+
+import "github.com/garethgeorge/backrest/internal/oplog"
+
+func init() {
+	testStores[SqliteOplogFile] = func(file string) (oplog.OpLog, error) { return oplog.NewSqliteStore(file) }
+}
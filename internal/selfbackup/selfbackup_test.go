@@ -0,0 +1,107 @@
+package selfbackup
+
+import (
+	"os"
+	"path"
+	"strings"
+	"testing"
+
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+	"github.com/garethgeorge/backrest/internal/oplog"
+)
+
+// testStores opens the oplog stores self backups are tested with by the name of their file.
+var testStores = map[string]func(file string) (oplog.OpLog, error){
+	OplogFile: func(file string) (oplog.OpLog, error) { return oplog.NewBoltStore(file) },
+}
+
+func TestStageAndInstall(t *testing.T) {
+	t.Parallel()
+	for oplogFile, openStore := range testStores {
+		oplogFile, openStore := oplogFile, openStore
+		t.Run(oplogFile, func(t *testing.T) {
+			t.Parallel()
+			dataDir := t.TempDir()
+			configPath := path.Join(t.TempDir(), "config.json")
+
+			log, err := openStore(path.Join(dataDir, oplogFile))
+			if err != nil {
+				t.Fatalf("failed to create oplog: %v", err)
+			}
+			if err := log.Add(&v1.Operation{UnixTimeStartMs: 1000, PlanId: "plan1", RepoId: "repo1", DisplayMessage: "op1", Op: &v1.Operation_OperationBackup{}}); err != nil {
+				t.Fatalf("failed to add operation: %v", err)
+			}
+			writeFile(t, configPath, "config")
+			writeFile(t, path.Join(dataDir, SecretFile), "secret")
+			writeFile(t, path.Join(dataDir, RotatingLogs, "log1"), "log")
+
+			stagingDir := path.Join(dataDir, "selfbackup")
+			err = Stage(log, dataDir, configPath, stagingDir)
+			log.Close()
+			if err != nil {
+				t.Fatalf("failed to stage: %v", err)
+			}
+
+			// install into a fresh location and over the existing state, which must be kept aside.
+			restoredDataDir := t.TempDir()
+			restoredConfigPath := path.Join(t.TempDir(), "config", "config.json")
+			if err := Install(stagingDir, restoredDataDir, restoredConfigPath); err != nil {
+				t.Fatalf("failed to install into empty dir: %v", err)
+			}
+			if err := Install(stagingDir, dataDir, configPath); err != nil {
+				t.Fatalf("failed to install over existing state: %v", err)
+			}
+
+			for _, dir := range []struct{ dataDir, configPath string }{{restoredDataDir, restoredConfigPath}, {dataDir, configPath}} {
+				readFile(t, dir.configPath, "config")
+				readFile(t, path.Join(dir.dataDir, SecretFile), "secret")
+				readFile(t, path.Join(dir.dataDir, RotatingLogs, "log1"), "log")
+
+				restored, err := openStore(path.Join(dir.dataDir, oplogFile))
+				if err != nil {
+					t.Fatalf("failed to open restored oplog: %v", err)
+				}
+				var messages []string
+				if _, err := restored.Query(oplog.Query{RepoId: "repo1"}, func(op *v1.Operation) error {
+					messages = append(messages, op.DisplayMessage)
+					return nil
+				}); err != nil {
+					t.Fatalf("failed to query restored oplog: %v", err)
+				}
+				restored.Close()
+				if len(messages) != 1 || messages[0] != "op1" {
+					t.Errorf("want restored operations [op1], got %v", messages)
+				}
+			}
+
+			ents, err := os.ReadDir(path.Dir(configPath))
+			if err != nil {
+				t.Fatalf("failed to read config dir: %v", err)
+			}
+			if len(ents) != 2 || !strings.HasPrefix(ents[1].Name(), "config.json.bak-") {
+				t.Errorf("want the replaced config moved aside, got %v", ents)
+			}
+		})
+	}
+}
+
+func writeFile(t *testing.T, p, content string) {
+	t.Helper()
+	if err := os.MkdirAll(path.Dir(p), 0700); err != nil {
+		t.Fatalf("failed to create dir: %v", err)
+	}
+	if err := os.WriteFile(p, []byte(content), 0600); err != nil {
+		t.Fatalf("failed to write %q: %v", p, err)
+	}
+}
+
+func readFile(t *testing.T, p, want string) {
+	t.Helper()
+	data, err := os.ReadFile(p)
+	if err != nil {
+		t.Fatalf("failed to read %q: %v", p, err)
+	}
+	if string(data) != want {
+		t.Errorf("want %q in %q, got %q", want, p, data)
+	}
+}
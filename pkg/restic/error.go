@@ -1,20 +1,73 @@
 package restic
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"os/exec"
+	"strings"
 )
 
 const outputBufferLimit = 1000
 
+// Sentinel errors classifying why a restic command failed, test for them with errors.Is.
+var (
+	ErrWrongPassword  = errors.New("wrong password")
+	ErrRepoNotFound   = errors.New("repo does not exist")
+	ErrRepoLocked     = errors.New("repo is locked")
+	ErrInterrupted    = errors.New("interrupted")
+	ErrBackendFailure = errors.New("network or backend failure")
+)
+
+// errorKinds is the set of sentinel errors returned by ErrorKind, ordered by precedence.
+var errorKinds = []error{
+	ErrWrongPassword, ErrRepoLocked, ErrBackendFailure, ErrRepoNotFound, ErrPartialBackup, ErrInterrupted, errAlreadyInitialized,
+}
+
+// exitCodeKinds maps restic's documented exit codes to the failures they indicate.
+var exitCodeKinds = map[int]error{
+	3:   ErrPartialBackup,
+	10:  ErrRepoNotFound,
+	11:  ErrRepoLocked,
+	12:  ErrWrongPassword,
+	130: ErrInterrupted,
+}
+
+// outputPatternKinds maps messages printed by restic to the failures they indicate, older versions of restic
+// exit with code 1 for all of these. Backend failures are listed before ErrRepoNotFound because restic suggests
+// the repo may not exist whenever it fails to read the repo's config file.
+var outputPatternKinds = []struct {
+	pattern string
+	kind    error
+}{
+	{"config file already exists", errAlreadyInitialized},
+	{"already initialized", errAlreadyInitialized},
+	{"wrong password or no key found", ErrWrongPassword},
+	{"repository is already locked", ErrRepoLocked},
+	{"unable to create lock in backend", ErrRepoLocked},
+	{"connection refused", ErrBackendFailure},
+	{"connection reset by peer", ErrBackendFailure},
+	{"no such host", ErrBackendFailure},
+	{"network is unreachable", ErrBackendFailure},
+	{"i/o timeout", ErrBackendFailure},
+	{"TLS handshake timeout", ErrBackendFailure},
+	{"repository does not exist", ErrRepoNotFound},
+	{"Is there a repository at the following location?", ErrRepoNotFound},
+	{"signal interrupt received", ErrInterrupted},
+}
+
 type CmdError struct {
 	Command string
 	Err     error
 	Output  string
+	Kind    error // the sentinel error classifying the failure, nil if unknown.
 }
 
 func (e *CmdError) Error() string {
 	m := fmt.Sprintf("command %q failed: %s", e.Command, e.Err.Error())
+	if e.Kind != nil && !errors.Is(e.Err, e.Kind) {
+		m = fmt.Sprintf("command %q failed, %v: %s", e.Command, e.Kind, e.Err.Error())
+	}
 	if e.Output != "" {
 		m += "\nProcess STDOUT: \n" + e.Output
 	}
@@ -26,16 +79,52 @@ func (e *CmdError) Unwrap() error {
 }
 
 func (e *CmdError) Is(target error) bool {
+	if e.Kind != nil && target == e.Kind {
+		return true
+	}
 	_, ok := target.(*CmdError)
 	return ok
 }
 
+// ErrorKind returns the sentinel error that classifies a failed restic command or nil if the failure is unknown.
+func ErrorKind(err error) error {
+	for _, kind := range errorKinds {
+		if errors.Is(err, kind) {
+			return kind
+		}
+	}
+	return nil
+}
+
+// classifyError maps the exit code and the output of a failed command to one of the sentinel errors, ctx is the context the command ran with.
+func classifyError(ctx context.Context, err error, output string) error {
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		if kind, ok := exitCodeKinds[exitErr.ExitCode()]; ok {
+			return kind
+		}
+	}
+
+	for _, p := range outputPatternKinds {
+		if strings.Contains(output, p.pattern) {
+			return p.kind
+		}
+	}
+
+	// the process was killed because the context was cancelled, other kills e.g. by the OOM killer are left unclassified.
+	if errors.Is(err, context.Canceled) || (ctx.Err() != nil && exitErr != nil && exitErr.ExitCode() == -1) {
+		return ErrInterrupted
+	}
+	return nil
+}
+
 // newCmdError creates a new error indicating that running a command failed.
-func newCmdError(cmd *exec.Cmd, output string, err error) *CmdError {
+func newCmdError(ctx context.Context, cmd *exec.Cmd, output string, err error) *CmdError {
 	cerr := &CmdError{
 		Command: cmd.String(),
 		Err:     err,
 		Output:  output,
+		Kind:    classifyError(ctx, err, output),
 	}
 
 	if len(output) >= outputBufferLimit {
@@ -45,10 +134,11 @@ func newCmdError(cmd *exec.Cmd, output string, err error) *CmdError {
 	return cerr
 }
 
-func newCmdErrorPreformatted(cmd *exec.Cmd, output string, err error) *CmdError {
+func newCmdErrorPreformatted(ctx context.Context, cmd *exec.Cmd, output string, err error) *CmdError {
 	return &CmdError{
 		Command: cmd.String(),
 		Err:     err,
 		Output:  output,
+		Kind:    classifyError(ctx, err, output),
 	}
 }
@@ -0,0 +1,128 @@
+package restic
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os/exec"
+	"testing"
+)
+
+func TestClassifyError(t *testing.T) {
+	t.Parallel()
+
+	exitErr := func(code int) error {
+		err := exec.Command("sh", "-c", fmt.Sprintf("exit %d", code)).Run()
+		if err == nil {
+			t.Fatalf("expected command to fail")
+		}
+		return err
+	}
+	killedErr := func() error {
+		err := exec.Command("sh", "-c", "kill -9 $$").Run()
+		if err == nil {
+			t.Fatalf("expected command to be killed")
+		}
+		return err
+	}
+
+	tests := []struct {
+		name      string
+		err       error
+		output    string
+		cancelled bool // whether the command's context was cancelled.
+		want      error
+	}{
+		{
+			name:   "wrong password message",
+			err:    exitErr(1),
+			output: "Fatal: wrong password or no key found\n",
+			want:   ErrWrongPassword,
+		},
+		{
+			name: "wrong password exit code",
+			err:  exitErr(12),
+			want: ErrWrongPassword,
+		},
+		{
+			name:   "repo does not exist",
+			err:    exitErr(1),
+			output: "Fatal: unable to open config file: stat /tmp/repo/config: no such file or directory\nIs there a repository at the following location?\n/tmp/repo\n",
+			want:   ErrRepoNotFound,
+		},
+		{
+			name:   "repo locked",
+			err:    exitErr(1),
+			output: "unable to create lock in backend: repository is already locked by PID 1234 on host by user (UID 0, GID 0)\n",
+			want:   ErrRepoLocked,
+		},
+		{
+			name: "partial backup",
+			err:  exitErr(3),
+			want: ErrPartialBackup,
+		},
+		{
+			name: "interrupted",
+			err:  exitErr(130),
+			want: ErrInterrupted,
+		},
+		{
+			name: "context cancelled",
+			err:  context.Canceled,
+			want: ErrInterrupted,
+		},
+		{
+			name:      "killed by cancelling the context",
+			err:       killedErr(),
+			cancelled: true,
+			want:      ErrInterrupted,
+		},
+		{
+			name: "killed by a signal",
+			err:  killedErr(),
+			want: nil,
+		},
+		{
+			name:   "already initialized",
+			err:    exitErr(1),
+			output: "Fatal: create key in repository at /tmp/repo failed: repository master key and config already initialized\n",
+			want:   errAlreadyInitialized,
+		},
+		{
+			name:   "backend unreachable takes precedence over missing repo",
+			err:    exitErr(1),
+			output: "Fatal: unable to open config file: Head \"http://localhost:8000/config\": dial tcp 127.0.0.1:8000: connect: connection refused\nIs there a repository at the following location?\n",
+			want:   ErrBackendFailure,
+		},
+		{
+			name:   "unknown",
+			err:    exitErr(1),
+			output: "Fatal: something unexpected\n",
+			want:   nil,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			if tc.cancelled {
+				cancel()
+			}
+			cerr := newCmdError(ctx, &exec.Cmd{}, tc.output, tc.err)
+			if cerr.Kind != tc.want {
+				t.Errorf("wanted kind %v, got: %v", tc.want, cerr.Kind)
+			}
+
+			wrapped := fmt.Errorf("wrapped: %w", cerr)
+			if got := ErrorKind(wrapped); got != tc.want {
+				t.Errorf("wanted ErrorKind %v, got: %v", tc.want, got)
+			}
+			if tc.want != nil && !errors.Is(wrapped, tc.want) {
+				t.Errorf("wanted errors.Is(err, %v) to be true", tc.want)
+			}
+		})
+	}
+}
@@ -2,12 +2,17 @@ package restic
 
 import (
 	"bufio"
+	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"os/exec"
+	"regexp"
 	"slices"
+	"strconv"
+	"strings"
 	"time"
 
 	v1 "github.com/garethgeorge/backrest/gen/go/v1"
@@ -94,7 +99,7 @@ func (b *BackupProgressEntry) Validate() error {
 }
 
 // readBackupProgressEntries returns the summary event or an error if the command failed.
-func readBackupProgressEntries(cmd *exec.Cmd, output io.Reader, callback func(event *BackupProgressEntry)) (*BackupProgressEntry, error) {
+func readBackupProgressEntries(ctx context.Context, cmd *exec.Cmd, output io.Reader, callback func(event *BackupProgressEntry)) (*BackupProgressEntry, error) {
 	scanner := bufio.NewScanner(output)
 	scanner.Split(bufio.ScanLines)
 
@@ -110,7 +115,7 @@ func readBackupProgressEntries(cmd *exec.Cmd, output io.Reader, callback func(ev
 				bytes = append(bytes, scanner.Bytes()...)
 			}
 
-			return nil, newCmdError(cmd, string(bytes), fmt.Errorf("command output was not JSON: %w", err))
+			return nil, newCmdError(ctx, cmd, string(bytes), fmt.Errorf("command output was not JSON: %w", err))
 		}
 		if err := event.Validate(); err != nil {
 			return nil, err
@@ -243,7 +248,7 @@ func (e *RestoreProgressEntry) Validate() error {
 }
 
 // readRestoreProgressEntries returns the summary event or an error if the command failed.
-func readRestoreProgressEntries(cmd *exec.Cmd, output io.Reader, callback func(event *RestoreProgressEntry)) (*RestoreProgressEntry, error) {
+func readRestoreProgressEntries(ctx context.Context, cmd *exec.Cmd, output io.Reader, callback func(event *RestoreProgressEntry)) (*RestoreProgressEntry, error) {
 	scanner := bufio.NewScanner(output)
 	scanner.Split(bufio.ScanLines)
 
@@ -259,7 +264,7 @@ func readRestoreProgressEntries(cmd *exec.Cmd, output io.Reader, callback func(e
 				bytes = append(bytes, scanner.Bytes()...)
 			}
 
-			return nil, newCmdError(cmd, string(bytes), fmt.Errorf("command output was not JSON: %w", err))
+			return nil, newCmdError(ctx, cmd, string(bytes), fmt.Errorf("command output was not JSON: %w", err))
 		}
 		if err := event.Validate(); err != nil {
 			return nil, err
@@ -319,3 +324,162 @@ type RepoStats struct {
 	TotalBlobCount         int64   `json:"total_blob_count"`
 	SnapshotsCount         int64   `json:"snapshots_count"`
 }
+
+// PruneStats is the summary of a prune run parsed from restic's (non-JSON) prune output.
+type PruneStats struct {
+	BlobsTotal         int64   // blobs in the repo before the prune.
+	BytesTotal         int64   // bytes in the repo before the prune.
+	BlobsUnused        int64   // unused blobs before the prune.
+	BytesUnused        int64   // unused bytes before the prune.
+	BlobsRepacked      int64   // blobs rewritten into new packs.
+	BytesRepacked      int64   // bytes rewritten into new packs.
+	BlobsRemoved       int64   // blobs removed, either deleted outright or dropped while repacking.
+	BytesRemoved       int64   // bytes freed by the prune.
+	BlobsRemaining     int64   // blobs remaining after the prune.
+	BytesRemaining     int64   // bytes remaining after the prune.
+	BytesUnusedAfter   int64   // unused bytes remaining after the prune.
+	PercentUnusedAfter float64 // unused bytes remaining as a percentage of the remaining size.
+	PacksRepacked      int64   // packs that were repacked.
+	PacksRemoved       int64   // packs that were deleted.
+}
+
+// UnusedPercent returns the unused bytes before the prune as a percentage of the total size of the repo.
+func (s *PruneStats) UnusedPercent() float64 {
+	if s.BytesTotal == 0 {
+		return 0
+	}
+	return float64(s.BytesUnused) / float64(s.BytesTotal) * 100
+}
+
+var (
+	pruneBlobsLineRe      = regexp.MustCompile(`^([a-z ]+):\s+(\d+) blobs / (.+)$`)
+	prunePacksLineRe      = regexp.MustCompile(`^([a-z ]+):\s+(\d+) packs$`)
+	pruneUnusedAfterRe    = regexp.MustCompile(`^unused size after prune: (.+) \(([\d.]+)% of remaining size\)$`)
+	pruneRemovingPacksRe  = regexp.MustCompile(`^removing (\d+) old packs$`)
+	pruneRepackedPacksRe  = regexp.MustCompile(`(\d+) / \d+ packs repacked$`)
+	formattedBytesRe      = regexp.MustCompile(`^([\d.]+) (B|KiB|MiB|GiB|TiB)$`)
+	formattedBytesFactors = map[string]float64{
+		"B":   1,
+		"KiB": 1 << 10,
+		"MiB": 1 << 20,
+		"GiB": 1 << 30,
+		"TiB": 1 << 40,
+	}
+)
+
+// readPruneStats parses the statistics printed by `restic prune`. Lines that are not recognized are skipped,
+// any statistic that restic did not print is left as zero.
+func readPruneStats(output io.Reader) (*PruneStats, error) {
+	scanner := bufio.NewScanner(output)
+	scanner.Split(scanLinesOrCarriageReturns)
+
+	stats := &PruneStats{}
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+
+		if m := pruneBlobsLineRe.FindStringSubmatch(line); m != nil {
+			blobs, _ := strconv.ParseInt(m[2], 10, 64)
+			size, err := parseFormattedBytes(m[3])
+			if err != nil {
+				continue
+			}
+			switch m[1] {
+			case "total":
+				stats.BlobsTotal, stats.BytesTotal = blobs, size
+			case "unused":
+				stats.BlobsUnused, stats.BytesUnused = blobs, size
+			case "to repack":
+				stats.BlobsRepacked, stats.BytesRepacked = blobs, size
+			case "total prune":
+				stats.BlobsRemoved, stats.BytesRemoved = blobs, size
+			case "remaining":
+				stats.BlobsRemaining, stats.BytesRemaining = blobs, size
+			}
+		} else if m := prunePacksLineRe.FindStringSubmatch(line); m != nil {
+			packs, _ := strconv.ParseInt(m[2], 10, 64)
+			switch m[1] {
+			case "to repack":
+				stats.PacksRepacked = packs
+			case "to delete":
+				stats.PacksRemoved = packs
+			}
+		} else if m := pruneUnusedAfterRe.FindStringSubmatch(line); m != nil {
+			if size, err := parseFormattedBytes(m[1]); err == nil {
+				stats.BytesUnusedAfter = size
+			}
+			stats.PercentUnusedAfter, _ = strconv.ParseFloat(m[2], 64)
+		} else if m := pruneRemovingPacksRe.FindStringSubmatch(line); m != nil {
+			stats.PacksRemoved, _ = strconv.ParseInt(m[1], 10, 64)
+		} else if m := pruneRepackedPacksRe.FindStringSubmatch(line); m != nil {
+			stats.PacksRepacked, _ = strconv.ParseInt(m[1], 10, 64)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return stats, fmt.Errorf("scanner encountered error: %w", err)
+	}
+
+	return stats, nil
+}
+
+// parseFormattedBytes parses a size formatted by restic e.g. "1.047 MiB" or "512 B".
+func parseFormattedBytes(s string) (int64, error) {
+	m := formattedBytesRe.FindStringSubmatch(strings.TrimSpace(s))
+	if m == nil {
+		return 0, fmt.Errorf("invalid size %q", s)
+	}
+	val, err := strconv.ParseFloat(m[1], 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid size %q: %w", s, err)
+	}
+	return int64(val * formattedBytesFactors[m[2]]), nil
+}
+
+// scanLinesOrCarriageReturns is a bufio.SplitFunc that splits on both '\n' and '\r', restic redraws progress bars with '\r'.
+func scanLinesOrCarriageReturns(data []byte, atEOF bool) (advance int, token []byte, err error) {
+	if atEOF && len(data) == 0 {
+		return 0, nil, nil
+	}
+	if i := bytes.IndexAny(data, "\r\n"); i >= 0 {
+		return i + 1, data[:i], nil
+	}
+	if atEOF {
+		return len(data), data, nil
+	}
+	return 0, nil, nil
+}
+
+// Lock is a lock held on the repo as printed by `restic cat lock`.
+type Lock struct {
+	Id        string    `json:"-"`
+	Time      time.Time `json:"time"`
+	Exclusive bool      `json:"exclusive"`
+	Hostname  string    `json:"hostname"`
+	Username  string    `json:"username"`
+	Pid       int       `json:"pid"`
+	UID       uint32    `json:"uid"`
+	GID       uint32    `json:"gid"`
+}
+
+// Age returns how long ago the lock was created or last refreshed, restic refreshes the locks it holds every 5 minutes.
+func (l *Lock) Age(now time.Time) time.Duration {
+	return now.Sub(l.Time)
+}
+
+var lockIdRe = regexp.MustCompile(`^[0-9a-f]{64}$`)
+
+// readLockIds reads the lock IDs printed by `restic list locks`, lines that aren't IDs e.g. warnings are skipped.
+func readLockIds(reader io.Reader) ([]string, error) {
+	var ids []string
+	scanner := bufio.NewScanner(reader)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if lockIdRe.MatchString(line) {
+			ids = append(ids, line)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("scanner encountered error: %w", err)
+	}
+	return ids, nil
+}
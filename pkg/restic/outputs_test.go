@@ -2,7 +2,9 @@ package restic
 
 import (
 	"bytes"
+	"context"
 	"os/exec"
+	"slices"
 	"testing"
 )
 
@@ -13,7 +15,7 @@ func TestReadBackupProgressEntries(t *testing.T) {
 
 	b := bytes.NewBuffer([]byte(testInput))
 
-	summary, err := readBackupProgressEntries(&exec.Cmd{}, b, func(event *BackupProgressEntry) {
+	summary, err := readBackupProgressEntries(context.Background(), &exec.Cmd{}, b, func(event *BackupProgressEntry) {
 		t.Logf("event: %v", event)
 	})
 	if err != nil {
@@ -46,3 +48,80 @@ func TestReadLs(t *testing.T) {
 		t.Errorf("wanted 3 entries, got: %d", len(entries))
 	}
 }
+
+func TestReadPruneStats(t *testing.T) {
+	t.Parallel()
+	testInput := "loading indexes...\n" +
+		"loading all snapshots...\n" +
+		"finding data that is still in use for 1 snapshots\n" +
+		"[0:00] 100.00%  1 / 1 snapshots\n" +
+		"searching used packs...\n" +
+		"collecting packs for deletion and repacking\n" +
+		"[0:00] 100.00%  5 / 5 packs processed\n" +
+		"\n" +
+		"used:                 16 blobs / 38.003 KiB\n" +
+		"unused:               74 blobs / 1.072 MiB\n" +
+		"total:                90 blobs / 1.109 MiB\n" +
+		"unused size: 96.65% of total size\n" +
+		"\n" +
+		"to repack:            69 blobs / 1.078 MiB\n" +
+		"this removes:         67 blobs / 1.047 MiB\n" +
+		"to delete:             7 blobs / 25.726 KiB\n" +
+		"total prune:          74 blobs / 1.072 MiB\n" +
+		"remaining:            16 blobs / 38.003 KiB\n" +
+		"unused size after prune: 512 B (1.32% of remaining size)\n" +
+		"\n" +
+		"repacking packs\n" +
+		"[0:00] 0.00%  0 / 1 packs repacked\r[0:00] 100.00%  1 / 1 packs repacked\n" +
+		"rebuilding index\n" +
+		"[0:00] 100.00%  3 / 3 packs processed\n" +
+		"deleting obsolete index files\n" +
+		"removing 2 old packs\n" +
+		"[0:00] 100.00%  2 / 2 files deleted\n" +
+		"done\n"
+
+	stats, err := readPruneStats(bytes.NewBufferString(testInput))
+	if err != nil {
+		t.Fatalf("failed to read prune stats: %v", err)
+	}
+
+	want := PruneStats{
+		BlobsTotal:         90,
+		BytesTotal:         1162870,
+		BlobsUnused:        74,
+		BytesUnused:        1124073,
+		BlobsRepacked:      69,
+		BytesRepacked:      1130364,
+		BlobsRemoved:       74,
+		BytesRemoved:       1124073,
+		BlobsRemaining:     16,
+		BytesRemaining:     38915,
+		BytesUnusedAfter:   512,
+		PercentUnusedAfter: 1.32,
+		PacksRepacked:      1,
+		PacksRemoved:       2,
+	}
+	if *stats != want {
+		t.Errorf("wanted prune stats %+v, got: %+v", want, *stats)
+	}
+}
+
+func TestReadLockIds(t *testing.T) {
+	t.Parallel()
+	testInput := "Warning: the repository is locked by another process\n" +
+		"2f2c6ec1e0fd5ae8ff53e0a02f9b0f7dbe4a8c40b94e41d79c1d7ef07e1a79d1\n" +
+		"\n" +
+		"  9a1cb5ab4a91c6c1fc4e97c3e0a24e8bd8f6d7b1f2d1d3b5a3c0e5e4f4b1a2c3  \n"
+
+	ids, err := readLockIds(bytes.NewBufferString(testInput))
+	if err != nil {
+		t.Fatalf("failed to read lock ids: %v", err)
+	}
+	want := []string{
+		"2f2c6ec1e0fd5ae8ff53e0a02f9b0f7dbe4a8c40b94e41d79c1d7ef07e1a79d1",
+		"9a1cb5ab4a91c6c1fc4e97c3e0a24e8bd8f6d7b1f2d1d3b5a3c0e5e4f4b1a2c3",
+	}
+	if !slices.Equal(ids, want) {
+		t.Errorf("wanted lock ids %v, got: %v", want, ids)
+	}
+}
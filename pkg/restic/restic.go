@@ -96,10 +96,7 @@ func (r *Repo) init(ctx context.Context, opts ...GenericOption) error {
 	r.pipeCmdOutputToWriter(cmd, output)
 
 	if err := cmd.Run(); err != nil {
-		if strings.Contains(output.String(), "config file already exists") || strings.Contains(output.String(), "already initialized") {
-			return errAlreadyInitialized
-		}
-		return newCmdError(cmd, output.String(), err)
+		return newCmdError(ctx, cmd, output.String(), err)
 	}
 
 	r.initialized = true
@@ -129,7 +126,7 @@ func (r *Repo) Backup(ctx context.Context, paths []string, progressCallback func
 	r.pipeCmdOutputToWriter(cmd, writer, capture)
 
 	if err := cmd.Start(); err != nil {
-		return nil, newCmdError(cmd, "", err)
+		return nil, newCmdError(ctx, cmd, "", err)
 	}
 
 	var wg sync.WaitGroup
@@ -141,7 +138,7 @@ func (r *Repo) Backup(ctx context.Context, paths []string, progressCallback func
 	go func() {
 		defer wg.Done()
 		var err error
-		summary, err = readBackupProgressEntries(cmd, reader, progressCallback)
+		summary, err = readBackupProgressEntries(ctx, cmd, reader, progressCallback)
 		if err != nil {
 			readErr = fmt.Errorf("processing command output: %w", err)
 		}
@@ -157,7 +154,7 @@ func (r *Repo) Backup(ctx context.Context, paths []string, progressCallback func
 				if exitErr.ExitCode() == 3 {
 					cmdErr = ErrPartialBackup
 				} else {
-					cmdErr = fmt.Errorf("exit code %v: %w", exitErr.ExitCode(), ErrBackupFailed)
+					cmdErr = fmt.Errorf("%w: %w", ErrBackupFailed, exitErr)
 				}
 				return
 			}
@@ -173,7 +170,7 @@ func (r *Repo) Backup(ctx context.Context, paths []string, progressCallback func
 	}
 
 	if cmdErr != nil || readErr != nil {
-		return summary, newCmdErrorPreformatted(cmd, capture.String(), errors.Join(cmdErr, readErr))
+		return summary, newCmdErrorPreformatted(ctx, cmd, capture.String(), errors.Join(cmdErr, readErr))
 	}
 
 	return summary, nil
@@ -186,12 +183,12 @@ func (r *Repo) Snapshots(ctx context.Context, opts ...GenericOption) ([]*Snapsho
 	r.pipeCmdOutputToLogger(ctx, cmd)
 
 	if err := cmd.Run(); err != nil {
-		return nil, newCmdError(cmd, output.String(), err)
+		return nil, newCmdError(ctx, cmd, output.String(), err)
 	}
 
 	var snapshots []*Snapshot
 	if err := json.Unmarshal(output.Bytes(), &snapshots); err != nil {
-		return nil, newCmdError(cmd, output.String(), fmt.Errorf("command output is not valid JSON: %w", err))
+		return nil, newCmdError(ctx, cmd, output.String(), fmt.Errorf("command output is not valid JSON: %w", err))
 	}
 
 	for _, snapshot := range snapshots {
@@ -211,18 +208,18 @@ func (r *Repo) Forget(ctx context.Context, policy *RetentionPolicy, opts ...Gene
 	r.pipeCmdOutputToWriter(cmd, output)
 	r.pipeCmdOutputToLogger(ctx, cmd)
 	if err := cmd.Run(); err != nil {
-		return nil, newCmdError(cmd, output.String(), err)
+		return nil, newCmdError(ctx, cmd, output.String(), err)
 	}
 
 	var result []ForgetResult
 	if err := json.Unmarshal(output.Bytes(), &result); err != nil {
-		return nil, newCmdError(cmd, output.String(), fmt.Errorf("command output is not valid JSON: %w", err))
+		return nil, newCmdError(ctx, cmd, output.String(), fmt.Errorf("command output is not valid JSON: %w", err))
 	}
 	if len(result) != 1 {
 		return nil, fmt.Errorf("expected 1 output from forget, got %v", len(result))
 	}
 	if err := result[0].Validate(); err != nil {
-		return nil, newCmdError(cmd, output.String(), fmt.Errorf("invalid forget result: %w", err))
+		return nil, newCmdError(ctx, cmd, output.String(), fmt.Errorf("invalid forget result: %w", err))
 	}
 
 	return &result[0], nil
@@ -236,13 +233,14 @@ func (r *Repo) ForgetSnapshot(ctx context.Context, snapshotId string, opts ...Ge
 	r.pipeCmdOutputToWriter(cmd, output)
 	r.pipeCmdOutputToLogger(ctx, cmd)
 	if err := cmd.Run(); err != nil {
-		return newCmdError(cmd, output.String(), err)
+		return newCmdError(ctx, cmd, output.String(), err)
 	}
 
 	return nil
 }
 
-func (r *Repo) Prune(ctx context.Context, pruneOutput io.Writer, opts ...GenericOption) error {
+// Prune runs `restic prune` and returns the statistics parsed from its output.
+func (r *Repo) Prune(ctx context.Context, pruneOutput io.Writer, opts ...GenericOption) (*PruneStats, error) {
 	args := []string{"prune"}
 	cmd := r.commandWithContext(ctx, args, opts...)
 	output := bytes.NewBuffer(nil)
@@ -252,9 +250,20 @@ func (r *Repo) Prune(ctx context.Context, pruneOutput io.Writer, opts ...Generic
 		r.pipeCmdOutputToWriter(cmd, pruneOutput)
 	}
 	if err := cmd.Run(); err != nil {
-		return newCmdErrorPreformatted(cmd, output.String(), err)
+		return nil, newCmdErrorPreformatted(ctx, cmd, output.String(), err)
 	}
-	return nil
+
+	stats, err := readPruneStats(bytes.NewReader(output.Bytes()))
+	if err != nil {
+		return nil, newCmdErrorPreformatted(ctx, cmd, output.String(), fmt.Errorf("parsing prune output: %w", err))
+	}
+	return stats, nil
+}
+
+// PruneDryRun runs `restic prune --dry-run`, the returned statistics describe the prune restic would have run. Nothing is removed from the repo.
+func (r *Repo) PruneDryRun(ctx context.Context, opts ...GenericOption) (*PruneStats, error) {
+	opts = append(opts, WithFlags("--dry-run"))
+	return r.Prune(ctx, nil, opts...)
 }
 
 func (r *Repo) Restore(ctx context.Context, snapshot string, callback func(*RestoreProgressEntry), opts ...GenericOption) (*RestoreProgressEntry, error) {
@@ -265,7 +274,7 @@ func (r *Repo) Restore(ctx context.Context, snapshot string, callback func(*Rest
 	r.pipeCmdOutputToLogger(ctx, cmd)
 
 	if err := cmd.Start(); err != nil {
-		return nil, newCmdError(cmd, "", err)
+		return nil, newCmdError(ctx, cmd, "", err)
 	}
 
 	var wg sync.WaitGroup
@@ -277,7 +286,7 @@ func (r *Repo) Restore(ctx context.Context, snapshot string, callback func(*Rest
 	go func() {
 		defer wg.Done()
 		var err error
-		summary, err = readRestoreProgressEntries(cmd, reader, callback)
+		summary, err = readRestoreProgressEntries(ctx, cmd, reader, callback)
 		if err != nil {
 			readErr = fmt.Errorf("processing command output: %w", err)
 		}
@@ -295,7 +304,7 @@ func (r *Repo) Restore(ctx context.Context, snapshot string, callback func(*Rest
 	wg.Wait()
 
 	if cmdErr != nil || readErr != nil {
-		return nil, newCmdErrorPreformatted(cmd, output.String(), errors.Join(cmdErr, readErr))
+		return nil, newCmdErrorPreformatted(ctx, cmd, output.String(), errors.Join(cmdErr, readErr))
 	}
 
 	return summary, nil
@@ -313,12 +322,12 @@ func (r *Repo) ListDirectory(ctx context.Context, snapshot string, path string,
 	r.pipeCmdOutputToLogger(ctx, cmd)
 
 	if err := cmd.Run(); err != nil {
-		return nil, nil, newCmdError(cmd, output.String(), err)
+		return nil, nil, newCmdError(ctx, cmd, output.String(), err)
 	}
 
 	snapshots, entries, err := readLs(output)
 	if err != nil {
-		return nil, nil, newCmdError(cmd, output.String(), err)
+		return nil, nil, newCmdError(ctx, cmd, output.String(), err)
 	}
 
 	return snapshots, entries, nil
@@ -330,11 +339,54 @@ func (r *Repo) Unlock(ctx context.Context, opts ...GenericOption) error {
 	r.pipeCmdOutputToWriter(cmd, output)
 	r.pipeCmdOutputToLogger(ctx, cmd)
 	if err := cmd.Run(); err != nil {
-		return newCmdError(cmd, output.String(), err)
+		return newCmdError(ctx, cmd, output.String(), err)
 	}
 	return nil
 }
 
+// ListLocks returns the locks currently held on the repo, it doesn't lock the repo itself.
+func (r *Repo) ListLocks(ctx context.Context, opts ...GenericOption) ([]*Lock, error) {
+	cmd := r.commandWithContext(ctx, []string{"list", "locks", "--no-lock"}, opts...)
+	output := bytes.NewBuffer(nil)
+	r.pipeCmdOutputToWriter(cmd, output)
+	r.pipeCmdOutputToLogger(ctx, cmd)
+	if err := cmd.Run(); err != nil {
+		return nil, newCmdError(ctx, cmd, output.String(), err)
+	}
+
+	ids, err := readLockIds(output)
+	if err != nil {
+		return nil, newCmdError(ctx, cmd, output.String(), err)
+	}
+
+	locks := make([]*Lock, 0, len(ids))
+	for _, id := range ids {
+		lock, err := r.catLock(ctx, id, opts...)
+		if err != nil {
+			return nil, err
+		}
+		locks = append(locks, lock)
+	}
+	return locks, nil
+}
+
+func (r *Repo) catLock(ctx context.Context, id string, opts ...GenericOption) (*Lock, error) {
+	cmd := r.commandWithContext(ctx, []string{"cat", "lock", id, "--no-lock"}, opts...)
+	output := bytes.NewBuffer(nil)
+	cmd.Stdout = output
+	stderr := bytes.NewBuffer(nil)
+	cmd.Stderr = stderr // kept separate from stdout so that warnings don't corrupt the JSON.
+	if err := cmd.Run(); err != nil {
+		return nil, newCmdError(ctx, cmd, output.String()+stderr.String(), err)
+	}
+
+	lock := &Lock{Id: id}
+	if err := json.Unmarshal(output.Bytes(), lock); err != nil {
+		return nil, newCmdError(ctx, cmd, output.String(), fmt.Errorf("command output is not valid JSON: %w", err))
+	}
+	return lock, nil
+}
+
 func (r *Repo) Stats(ctx context.Context, opts ...GenericOption) (*RepoStats, error) {
 	cmd := r.commandWithContext(ctx, []string{"stats", "--json", "--mode=raw-data"}, opts...)
 	output := bytes.NewBuffer(nil)
@@ -342,12 +394,12 @@ func (r *Repo) Stats(ctx context.Context, opts ...GenericOption) (*RepoStats, er
 	r.pipeCmdOutputToLogger(ctx, cmd)
 
 	if err := cmd.Run(); err != nil {
-		return nil, newCmdError(cmd, output.String(), err)
+		return nil, newCmdError(ctx, cmd, output.String(), err)
 	}
 
 	var stats RepoStats
 	if err := json.Unmarshal(output.Bytes(), &stats); err != nil {
-		return nil, newCmdError(cmd, output.String(), fmt.Errorf("command output is not valid JSON: %w", err))
+		return nil, newCmdError(ctx, cmd, output.String(), fmt.Errorf("command output is not valid JSON: %w", err))
 	}
 
 	return &stats, nil
@@ -23,6 +23,22 @@ func TestResticInit(t *testing.T) {
 	}
 }
 
+func TestResticWrongPassword(t *testing.T) {
+	t.Parallel()
+	repo := t.TempDir()
+
+	r := NewRepo(helpers.ResticBinary(t), repo, WithFlags("--no-cache"), WithEnv("RESTIC_PASSWORD=test"))
+	if err := r.Init(context.Background()); err != nil {
+		t.Fatalf("failed to init repo: %v", err)
+	}
+
+	wrong := NewRepo(helpers.ResticBinary(t), repo, WithFlags("--no-cache"), WithEnv("RESTIC_PASSWORD=wrong"))
+	_, err := wrong.Snapshots(context.Background())
+	if !errors.Is(err, ErrWrongPassword) {
+		t.Fatalf("wanted error to be wrong password, got: %v", err)
+	}
+}
+
 func TestResticBackup(t *testing.T) {
 	t.Parallel()
 	repo := t.TempDir()
@@ -373,7 +389,8 @@ func TestResticPrune(t *testing.T) {
 
 	// prune all snapshots
 	output := bytes.NewBuffer(nil)
-	if err := r.Prune(context.Background(), output); err != nil {
+	stats, err := r.Prune(context.Background(), output)
+	if err != nil {
 		t.Fatalf("failed to prune snapshots: %v", err)
 	}
 
@@ -382,6 +399,53 @@ func TestResticPrune(t *testing.T) {
 	if !bytes.Contains(output.Bytes(), []byte(wantStr)) {
 		t.Errorf("wanted output to contain 'keep 1 snapshots', got: %s", output.String())
 	}
+
+	if stats.BlobsTotal == 0 || stats.BytesTotal == 0 {
+		t.Errorf("wanted non-zero totals in prune stats, got: %+v", stats)
+	}
+	if stats.BytesRemaining == 0 {
+		t.Errorf("wanted non-zero remaining bytes in prune stats, got: %+v", stats)
+	}
+}
+
+func TestResticPruneDryRun(t *testing.T) {
+	t.Parallel()
+
+	repo := t.TempDir()
+	r := NewRepo(helpers.ResticBinary(t), repo, WithFlags("--no-cache"), WithEnv("RESTIC_PASSWORD=test"))
+	if err := r.Init(context.Background()); err != nil {
+		t.Fatalf("failed to init repo: %v", err)
+	}
+
+	for i := 0; i < 3; i++ {
+		testData := helpers.CreateTestData(t)
+		_, err := r.Backup(context.Background(), []string{testData}, nil)
+		if err != nil {
+			t.Fatalf("failed to backup: %v", err)
+		}
+	}
+
+	_, err := r.Forget(context.Background(), &RetentionPolicy{KeepLastN: 1})
+	if err != nil {
+		t.Fatalf("failed to forget snapshots: %v", err)
+	}
+
+	stats, err := r.PruneDryRun(context.Background())
+	if err != nil {
+		t.Fatalf("failed to dry run prune: %v", err)
+	}
+	if stats.BytesUnused == 0 || stats.UnusedPercent() == 0 {
+		t.Errorf("wanted non-zero unused space after forgetting snapshots, got: %+v", stats)
+	}
+
+	// a dry run must not remove anything, a second dry run should report the same unused space.
+	again, err := r.PruneDryRun(context.Background())
+	if err != nil {
+		t.Fatalf("failed to dry run prune: %v", err)
+	}
+	if again.BytesUnused != stats.BytesUnused {
+		t.Errorf("wanted unused bytes to be unchanged by dry run, got %d then %d", stats.BytesUnused, again.BytesUnused)
+	}
 }
 
 func TestResticRestore(t *testing.T) {
@@ -450,3 +514,21 @@ func TestResticStats(t *testing.T) {
 		t.Errorf("wanted non-zero total blob count, got: %d", stats.TotalBlobCount)
 	}
 }
+
+func TestResticListLocks(t *testing.T) {
+	t.Parallel()
+
+	repo := t.TempDir()
+	r := NewRepo(helpers.ResticBinary(t), repo, WithFlags("--no-cache"), WithEnv("RESTIC_PASSWORD=test"))
+	if err := r.Init(context.Background()); err != nil {
+		t.Fatalf("failed to init repo: %v", err)
+	}
+
+	locks, err := r.ListLocks(context.Background())
+	if err != nil {
+		t.Fatalf("failed to list locks: %v", err)
+	}
+	if len(locks) != 0 {
+		t.Errorf("wanted no locks on an idle repo, got: %v", locks)
+	}
+}
@@ -0,0 +1,129 @@
+package resticrepo
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"golang.org/x/crypto/poly1305"
+	"golang.org/x/crypto/scrypt"
+)
+
+const (
+	ivSize    = aes.BlockSize
+	macSize   = poly1305.TagSize
+	extension = ivSize + macSize // bytes added to the plaintext by encryption.
+)
+
+var (
+	ErrUnauthenticated = errors.New("ciphertext verification failed")
+	ErrWrongPassword   = errors.New("wrong password or no key found")
+)
+
+// MACKey is the key used to compute the Poly1305-AES MAC of a ciphertext.
+type MACKey struct {
+	K [16]byte // AES-128 key used to encrypt the nonce.
+	R [16]byte // Poly1305 r value.
+}
+
+// Key is the master key of a repo, it encrypts and authenticates every file stored in the repo.
+type Key struct {
+	MAC           MACKey
+	EncryptionKey [32]byte // AES-256 key.
+}
+
+// keyFile is a file in the repo's keys/ directory, it stores the master key encrypted with a key derived from a password.
+type keyFile struct {
+	Hostname string `json:"hostname"`
+	Username string `json:"username"`
+	KDF      string `json:"kdf"`
+	N        int    `json:"N"`
+	R        int    `json:"r"`
+	P        int    `json:"p"`
+	Salt     []byte `json:"salt"`
+	Data     []byte `json:"data"`
+}
+
+type jsonMasterKey struct {
+	MAC struct {
+		K []byte `json:"k"`
+		R []byte `json:"r"`
+	} `json:"mac"`
+	Encrypt []byte `json:"encrypt"`
+}
+
+// open derives the user key from the password and uses it to decrypt the master key.
+func (f *keyFile) open(password string) (*Key, error) {
+	if f.KDF != "scrypt" {
+		return nil, fmt.Errorf("unsupported kdf %q", f.KDF)
+	}
+
+	derived, err := scrypt.Key([]byte(password), f.Salt, f.N, f.R, f.P, 64)
+	if err != nil {
+		return nil, fmt.Errorf("derive key: %w", err)
+	}
+	userKey := &Key{}
+	copy(userKey.EncryptionKey[:], derived[:32])
+	copy(userKey.MAC.K[:], derived[32:48])
+	copy(userKey.MAC.R[:], derived[48:])
+
+	plaintext, err := userKey.decrypt(f.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	var master jsonMasterKey
+	if err := json.Unmarshal(plaintext, &master); err != nil {
+		return nil, fmt.Errorf("decode master key: %w", err)
+	}
+	if len(master.MAC.K) != 16 || len(master.MAC.R) != 16 || len(master.Encrypt) != 32 {
+		return nil, errors.New("invalid master key")
+	}
+
+	key := &Key{}
+	copy(key.EncryptionKey[:], master.Encrypt)
+	copy(key.MAC.K[:], master.MAC.K)
+	copy(key.MAC.R[:], master.MAC.R)
+	return key, nil
+}
+
+// decrypt verifies and decrypts a ciphertext stored as IV || AES-256-CTR ciphertext || Poly1305-AES MAC.
+func (k *Key) decrypt(ciphertext []byte) ([]byte, error) {
+	if len(ciphertext) < extension {
+		return nil, errors.New("ciphertext too short")
+	}
+
+	iv := ciphertext[:ivSize]
+	mac := ciphertext[len(ciphertext)-macSize:]
+	ciphertext = ciphertext[ivSize : len(ciphertext)-macSize]
+
+	if !k.verifyMAC(iv, ciphertext, mac) {
+		return nil, ErrUnauthenticated
+	}
+
+	block, err := aes.NewCipher(k.EncryptionKey[:])
+	if err != nil {
+		return nil, err
+	}
+	plaintext := make([]byte, len(ciphertext))
+	cipher.NewCTR(block, iv).XORKeyStream(plaintext, ciphertext)
+	return plaintext, nil
+}
+
+// verifyMAC checks the Poly1305-AES MAC of msg, the one-time Poly1305 key is r || AES_k(nonce).
+func (k *Key) verifyMAC(nonce, msg, mac []byte) bool {
+	block, err := aes.NewCipher(k.MAC.K[:])
+	if err != nil {
+		return false
+	}
+
+	var polyKey [32]byte
+	copy(polyKey[:16], k.MAC.R[:])
+	block.Encrypt(polyKey[16:], nonce)
+
+	var tag [macSize]byte
+	copy(tag[:], mac)
+	return poly1305.Verify(&tag, msg, &polyKey)
+}
@@ -0,0 +1,164 @@
+package resticrepo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// indexedBlob is the location of a blob within a pack file.
+type indexedBlob struct {
+	ID                 string
+	Type               string // "tree" or "data"
+	PackID             string
+	Offset             int64
+	Length             int64
+	UncompressedLength int64 // non-zero if the blob is compressed.
+}
+
+type indexJSON struct {
+	Supersedes []string `json:"supersedes"`
+	Packs      []struct {
+		ID    string `json:"id"`
+		Blobs []struct {
+			ID                 string `json:"id"`
+			Type               string `json:"type"`
+			Offset             int64  `json:"offset"`
+			Length             int64  `json:"length"`
+			UncompressedLength int64  `json:"uncompressed_length"`
+		} `json:"blobs"`
+	} `json:"packs"`
+}
+
+// refreshIndex loads index files added since the last refresh and forgets index files that were removed e.g. by a prune.
+// Each index file is only decrypted once.
+func (r *Repo) refreshIndex(ctx context.Context) error {
+	ids, err := r.listFiles("index")
+	if err != nil {
+		return fmt.Errorf("list index files: %w", err)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	current := make(map[string]struct{}, len(ids))
+	changed := false
+	for _, id := range ids {
+		current[id] = struct{}{}
+		if _, ok := r.indexFiles[id]; ok {
+			continue
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		blobs, err := r.loadIndexFile(id)
+		if err != nil {
+			return fmt.Errorf("load index %v: %w", id, err)
+		}
+		r.indexFiles[id] = blobs
+		changed = true
+	}
+	for id := range r.indexFiles {
+		if _, ok := current[id]; !ok {
+			delete(r.indexFiles, id)
+			changed = true
+		}
+	}
+
+	if changed {
+		r.blobs = make(map[string]*indexedBlob, len(r.blobs))
+		for _, blobs := range r.indexFiles {
+			for _, blob := range blobs {
+				r.blobs[blob.ID] = blob
+			}
+		}
+	}
+	return nil
+}
+
+func (r *Repo) loadIndexFile(id string) ([]*indexedBlob, error) {
+	data, err := r.loadFile(filepath.Join("index", id))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > 0 && data[0] == '[' {
+		return nil, fmt.Errorf("legacy index format is not supported, run `restic migrate` or `restic rebuild-index`")
+	}
+
+	var idx indexJSON
+	if err := json.Unmarshal(data, &idx); err != nil {
+		return nil, fmt.Errorf("decode index: %w", err)
+	}
+
+	var blobs []*indexedBlob
+	for _, pack := range idx.Packs {
+		for _, b := range pack.Blobs {
+			blobs = append(blobs, &indexedBlob{
+				ID:                 b.ID,
+				Type:               b.Type,
+				PackID:             pack.ID,
+				Offset:             b.Offset,
+				Length:             b.Length,
+				UncompressedLength: b.UncompressedLength,
+			})
+		}
+	}
+	return blobs, nil
+}
+
+func (r *Repo) lookupBlob(ctx context.Context, id string) (*indexedBlob, error) {
+	r.mu.Lock()
+	blob, ok := r.blobs[id]
+	r.mu.Unlock()
+	if ok {
+		return blob, nil
+	}
+
+	// the blob may have been added by a backup since the index was last loaded.
+	if err := r.refreshIndex(ctx); err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	blob, ok = r.blobs[id]
+	r.mu.Unlock()
+	if !ok {
+		return nil, fmt.Errorf("blob %v: %w", id, ErrNotFound)
+	}
+	return blob, nil
+}
+
+// loadBlob reads, decrypts and decompresses a blob from its pack file.
+func (r *Repo) loadBlob(ctx context.Context, id string) ([]byte, error) {
+	blob, err := r.lookupBlob(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := os.Open(filepath.Join(r.path, "data", blob.PackID[:2], blob.PackID))
+	if err != nil {
+		return nil, fmt.Errorf("open pack %v: %w", blob.PackID, err)
+	}
+	defer f.Close()
+
+	ciphertext := make([]byte, blob.Length)
+	if n, err := f.ReadAt(ciphertext, blob.Offset); n != len(ciphertext) {
+		return nil, fmt.Errorf("read blob %v from pack %v: %w", id, blob.PackID, err)
+	}
+
+	plaintext, err := r.key.decrypt(ciphertext)
+	if err != nil {
+		return nil, fmt.Errorf("decrypt blob %v: %w", id, err)
+	}
+
+	if blob.UncompressedLength != 0 {
+		plaintext, err = r.decoder.DecodeAll(plaintext, make([]byte, 0, blob.UncompressedLength))
+		if err != nil {
+			return nil, fmt.Errorf("decompress blob %v: %w", id, err)
+		}
+	}
+	return plaintext, nil
+}
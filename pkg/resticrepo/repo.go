@@ -0,0 +1,157 @@
+// Package resticrepo reads restic repositories stored on the local filesystem without running restic.
+// It implements the read path of restic's repository format (https://restic.readthedocs.io/en/stable/100_references.html#design):
+// decrypting the master key, the index, snapshots and tree blobs. It never writes to the repo or takes locks.
+package resticrepo
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+const maxCachedTrees = 1024
+
+var ErrNotFound = errors.New("not found")
+
+// Config is the repo's config file.
+type Config struct {
+	Version           int    `json:"version"`
+	ID                string `json:"id"`
+	ChunkerPolynomial string `json:"chunker_polynomial"`
+}
+
+// Repo reads a restic repository stored at a local path. It is safe for concurrent use.
+type Repo struct {
+	path    string
+	key     *Key
+	config  Config
+	decoder *zstd.Decoder
+
+	mu         sync.Mutex
+	indexFiles map[string][]*indexedBlob // parsed index files by file id.
+	blobs      map[string]*indexedBlob   // blob locations by blob id, built from indexFiles.
+	trees      map[string]*Tree          // cache of decoded trees, trees are immutable.
+}
+
+// Open opens the repo at path, it fails with ErrWrongPassword if no key can be decrypted with the password.
+func Open(ctx context.Context, path string, password string) (*Repo, error) {
+	key, err := openKey(ctx, path, password)
+	if err != nil {
+		return nil, err
+	}
+
+	decoder, err := zstd.NewReader(nil)
+	if err != nil {
+		return nil, fmt.Errorf("create zstd decoder: %w", err)
+	}
+
+	r := &Repo{
+		path:       path,
+		key:        key,
+		decoder:    decoder,
+		indexFiles: make(map[string][]*indexedBlob),
+		blobs:      make(map[string]*indexedBlob),
+		trees:      make(map[string]*Tree),
+	}
+
+	data, err := r.loadFile("config")
+	if err != nil {
+		return nil, fmt.Errorf("load config: %w", err)
+	}
+	if err := json.Unmarshal(data, &r.config); err != nil {
+		return nil, fmt.Errorf("decode config: %w", err)
+	}
+	if r.config.Version != 1 && r.config.Version != 2 {
+		return nil, fmt.Errorf("unsupported repo version %d", r.config.Version)
+	}
+
+	return r, nil
+}
+
+func openKey(ctx context.Context, path string, password string) (*Key, error) {
+	entries, err := os.ReadDir(filepath.Join(path, "keys"))
+	if err != nil {
+		return nil, fmt.Errorf("list keys: %w", err)
+	}
+
+	for _, entry := range entries {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		data, err := os.ReadFile(filepath.Join(path, "keys", entry.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("read key %v: %w", entry.Name(), err)
+		}
+		var f keyFile
+		if err := json.Unmarshal(data, &f); err != nil {
+			return nil, fmt.Errorf("decode key %v: %w", entry.Name(), err)
+		}
+
+		key, err := f.open(password)
+		if errors.Is(err, ErrUnauthenticated) {
+			continue // the key was created with a different password.
+		} else if err != nil {
+			return nil, fmt.Errorf("open key %v: %w", entry.Name(), err)
+		}
+		return key, nil
+	}
+
+	return nil, ErrWrongPassword
+}
+
+// Config returns the repo's config.
+func (r *Repo) Config() Config {
+	return r.config
+}
+
+// loadFile reads and decrypts a file that isn't a pack e.g. the config, an index or a snapshot.
+func (r *Repo) loadFile(name string) ([]byte, error) {
+	data, err := os.ReadFile(filepath.Join(r.path, name))
+	if err != nil {
+		return nil, err
+	}
+	plaintext, err := r.key.decrypt(data)
+	if err != nil {
+		return nil, fmt.Errorf("decrypt %v: %w", name, err)
+	}
+	if name == "config" {
+		return plaintext, nil // the config is never compressed.
+	}
+	return r.decodeUnpacked(plaintext)
+}
+
+// decodeUnpacked decompresses a file that isn't a pack. Repo version 2 prefixes compressed files with a version byte,
+// uncompressed files are plain JSON and start with '[' or '{'.
+func (r *Repo) decodeUnpacked(plaintext []byte) ([]byte, error) {
+	if r.config.Version < 2 || len(plaintext) == 0 || plaintext[0] == '[' || plaintext[0] == '{' {
+		return plaintext, nil
+	}
+	if plaintext[0] != 2 {
+		return nil, fmt.Errorf("unsupported encoding version %d", plaintext[0])
+	}
+	return r.decoder.DecodeAll(plaintext[1:], nil)
+}
+
+// listFiles returns the ids of the files in a directory of the repo e.g. "index" or "snapshots".
+func (r *Repo) listFiles(dir string) ([]string, error) {
+	entries, err := os.ReadDir(filepath.Join(r.path, dir))
+	if err != nil {
+		return nil, err
+	}
+	var ids []string
+	for _, entry := range entries {
+		if entry.IsDir() || strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+		ids = append(ids, entry.Name())
+	}
+	return ids, nil
+}
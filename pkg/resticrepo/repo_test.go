@@ -0,0 +1,109 @@
+package resticrepo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/garethgeorge/backrest/pkg/restic"
+	"github.com/garethgeorge/backrest/test/helpers"
+)
+
+// createTestRepo creates a repo with the restic binary and backs up the test data into it.
+func createTestRepo(t *testing.T, initFlags []string, flags ...string) (string, string, *restic.BackupProgressEntry) {
+	t.Helper()
+
+	repo := t.TempDir()
+	r := restic.NewRepo(helpers.ResticBinary(t), repo, restic.WithFlags(append([]string{"--no-cache"}, flags...)...), restic.WithEnv("RESTIC_PASSWORD=test"))
+	if err := r.Init(context.Background(), restic.WithFlags(initFlags...)); err != nil {
+		t.Fatalf("failed to init repo: %v", err)
+	}
+
+	testData := helpers.CreateTestData(t)
+	summary, err := r.Backup(context.Background(), []string{testData}, nil)
+	if err != nil {
+		t.Fatalf("failed to backup: %v", err)
+	}
+	return repo, testData, summary
+}
+
+func TestOpenWrongPassword(t *testing.T) {
+	t.Parallel()
+	repo, _, _ := createTestRepo(t, nil)
+
+	if _, err := Open(context.Background(), repo, "wrong"); !errors.Is(err, ErrWrongPassword) {
+		t.Fatalf("wanted ErrWrongPassword, got: %v", err)
+	}
+}
+
+func TestListDirectory(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		initFlags []string
+		flags     []string
+	}{
+		{name: "compressed"},
+		{name: "uncompressed", flags: []string{"--compression", "off"}},
+		{name: "repo version 1", initFlags: []string{"--repository-version", "1"}},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			repo, testData, summary := createTestRepo(t, tc.initFlags, tc.flags...)
+
+			r, err := Open(context.Background(), repo, "test")
+			if err != nil {
+				t.Fatalf("failed to open repo: %v", err)
+			}
+
+			snapshots, err := r.Snapshots(context.Background())
+			if err != nil {
+				t.Fatalf("failed to list snapshots: %v", err)
+			}
+			if len(snapshots) != 1 || snapshots[0].ID != summary.SnapshotId {
+				t.Fatalf("wanted snapshot %v, got: %v", summary.SnapshotId, snapshots)
+			}
+
+			dir, entries, err := r.ListDirectory(context.Background(), summary.SnapshotId[:8], testData)
+			if err != nil {
+				t.Fatalf("failed to list directory: %v", err)
+			}
+			if dir.Path != testData || dir.Type != "dir" {
+				t.Errorf("wanted dir %v, got: %+v", testData, dir)
+			}
+			if len(entries) != 100 {
+				t.Fatalf("wanted 100 entries, got: %d", len(entries))
+			}
+
+			var names []string
+			for _, entry := range entries {
+				names = append(names, entry.Name)
+				if entry.Path != filepath.Join(testData, entry.Name) {
+					t.Errorf("wanted path %v, got: %v", filepath.Join(testData, entry.Name), entry.Path)
+				}
+			}
+			if !slices.Contains(names, fmt.Sprintf("file%2d", 42)) {
+				t.Errorf("wanted entry file42, got: %v", names)
+			}
+
+			node, err := r.Stat(context.Background(), summary.SnapshotId, filepath.Join(testData, "file 1"))
+			if err != nil {
+				t.Fatalf("failed to stat file: %v", err)
+			}
+			if node.Type != "file" || node.Size != uint64(len("test data 1")) {
+				t.Errorf("wanted file of size %d, got: %+v", len("test data 1"), node)
+			}
+
+			if _, err := r.Stat(context.Background(), summary.SnapshotId, filepath.Join(testData, "missing")); !errors.Is(err, ErrNotFound) {
+				t.Errorf("wanted ErrNotFound for a missing file, got: %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package resticrepo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// Snapshot is a snapshot file from the repo's snapshots/ directory.
+type Snapshot struct {
+	ID       string    `json:"-"`
+	Time     time.Time `json:"time"`
+	Parent   string    `json:"parent,omitempty"`
+	Tree     string    `json:"tree"`
+	Paths    []string  `json:"paths"`
+	Hostname string    `json:"hostname,omitempty"`
+	Username string    `json:"username,omitempty"`
+	Tags     []string  `json:"tags,omitempty"`
+}
+
+// Snapshots loads all snapshots in the repo.
+func (r *Repo) Snapshots(ctx context.Context) ([]*Snapshot, error) {
+	ids, err := r.listFiles("snapshots")
+	if err != nil {
+		return nil, fmt.Errorf("list snapshots: %w", err)
+	}
+
+	snapshots := make([]*Snapshot, 0, len(ids))
+	for _, id := range ids {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		snapshot, err := r.loadSnapshot(id)
+		if err != nil {
+			return nil, err
+		}
+		snapshots = append(snapshots, snapshot)
+	}
+	return snapshots, nil
+}
+
+// Snapshot loads the snapshot with the given id, id may be a unique prefix of the snapshot's id.
+func (r *Repo) Snapshot(ctx context.Context, id string) (*Snapshot, error) {
+	if id == "" {
+		return nil, fmt.Errorf("snapshot id must not be empty")
+	}
+
+	ids, err := r.listFiles("snapshots")
+	if err != nil {
+		return nil, fmt.Errorf("list snapshots: %w", err)
+	}
+
+	var match string
+	for _, candidate := range ids {
+		if !strings.HasPrefix(candidate, id) {
+			continue
+		}
+		if match != "" {
+			return nil, fmt.Errorf("snapshot id prefix %q is ambiguous", id)
+		}
+		match = candidate
+	}
+	if match == "" {
+		return nil, fmt.Errorf("snapshot %v: %w", id, ErrNotFound)
+	}
+
+	return r.loadSnapshot(match)
+}
+
+func (r *Repo) loadSnapshot(id string) (*Snapshot, error) {
+	data, err := r.loadFile(filepath.Join("snapshots", id))
+	if err != nil {
+		return nil, fmt.Errorf("load snapshot %v: %w", id, err)
+	}
+	snapshot := &Snapshot{ID: id}
+	if err := json.Unmarshal(data, snapshot); err != nil {
+		return nil, fmt.Errorf("decode snapshot %v: %w", id, err)
+	}
+	return snapshot, nil
+}
@@ -0,0 +1,139 @@
+package resticrepo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+	"strings"
+)
+
+// Node is a file, directory or other filesystem object stored in a tree.
+type Node struct {
+	Name       string   `json:"name"`
+	Type       string   `json:"type"` // "file", "dir", "symlink", "dev", "chardev", "fifo" or "socket"
+	Mode       uint32   `json:"mode,omitempty"`
+	ModTime    string   `json:"mtime,omitempty"`
+	AccessTime string   `json:"atime,omitempty"`
+	ChangeTime string   `json:"ctime,omitempty"`
+	UID        uint32   `json:"uid"`
+	GID        uint32   `json:"gid"`
+	User       string   `json:"user,omitempty"`
+	Group      string   `json:"group,omitempty"`
+	Size       uint64   `json:"size,omitempty"`
+	LinkTarget string   `json:"linktarget,omitempty"`
+	Content    []string `json:"content"`
+	Subtree    string   `json:"subtree,omitempty"`
+
+	Path string `json:"-"` // absolute path of the node within the snapshot, not stored in the repo.
+}
+
+// Tree is a directory listing stored as a tree blob.
+type Tree struct {
+	Nodes []*Node `json:"nodes"`
+}
+
+// LoadTree loads the tree blob with the given id.
+func (r *Repo) LoadTree(ctx context.Context, id string) (*Tree, error) {
+	r.mu.Lock()
+	tree, ok := r.trees[id]
+	r.mu.Unlock()
+	if ok {
+		return tree, nil
+	}
+
+	data, err := r.loadBlob(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	tree = &Tree{}
+	if err := json.Unmarshal(data, tree); err != nil {
+		return nil, fmt.Errorf("decode tree %v: %w", id, err)
+	}
+
+	r.mu.Lock()
+	if len(r.trees) >= maxCachedTrees {
+		r.trees = make(map[string]*Tree) // trees are cheap to reload, dropping the whole cache keeps it bounded.
+	}
+	r.trees[id] = tree
+	r.mu.Unlock()
+
+	return tree, nil
+}
+
+// Stat returns the node at the absolute path p within the snapshot.
+func (r *Repo) Stat(ctx context.Context, snapshotId string, p string) (*Node, error) {
+	snapshot, err := r.Snapshot(ctx, snapshotId)
+	if err != nil {
+		return nil, err
+	}
+	return r.stat(ctx, snapshot, p)
+}
+
+// ListDirectory returns the directory at the absolute path p within the snapshot and its direct children,
+// matching the entries printed by `restic ls <snapshot> <path>`.
+func (r *Repo) ListDirectory(ctx context.Context, snapshotId string, p string) (*Node, []*Node, error) {
+	snapshot, err := r.Snapshot(ctx, snapshotId)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	dir, err := r.stat(ctx, snapshot, p)
+	if err != nil {
+		return nil, nil, err
+	}
+	if dir.Type != "dir" {
+		return dir, nil, nil
+	}
+
+	tree, err := r.LoadTree(ctx, dir.Subtree)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	children := make([]*Node, 0, len(tree.Nodes))
+	for _, node := range tree.Nodes {
+		child := *node
+		child.Path = path.Join(dir.Path, node.Name)
+		children = append(children, &child)
+	}
+	return dir, children, nil
+}
+
+func (r *Repo) stat(ctx context.Context, snapshot *Snapshot, p string) (*Node, error) {
+	p = path.Clean("/" + p)
+
+	// the root of the snapshot has no node of its own.
+	node := &Node{Name: "/", Type: "dir", Subtree: snapshot.Tree, Path: "/"}
+	if p == "/" {
+		return node, nil
+	}
+
+	for _, name := range strings.Split(strings.TrimPrefix(p, "/"), "/") {
+		if node.Type != "dir" {
+			return nil, fmt.Errorf("path %v: %w", p, ErrNotFound)
+		}
+
+		tree, err := r.LoadTree(ctx, node.Subtree)
+		if err != nil {
+			return nil, err
+		}
+
+		var next *Node
+		for _, child := range tree.Nodes {
+			if child.Name == name {
+				next = child
+				break
+			}
+		}
+		if next == nil {
+			return nil, fmt.Errorf("path %v: %w", p, ErrNotFound)
+		}
+
+		parent := node.Path
+		node = &Node{}
+		*node = *next
+		node.Path = path.Join(parent, name)
+	}
+	return node, nil
+}
@@ -0,0 +1,77 @@
+package helpers
+
+import (
+	"net"
+	"net/textproto"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// SMTPServer accepts a single SMTP session on a local port and records what the client sent. The recorded fields are safe to
+// read once Done is closed.
+type SMTPServer struct {
+	Host       string
+	Port       int
+	Auth       string   // the argument of the AUTH command, empty if the client didn't authenticate.
+	Recipients []string // the arguments of the RCPT commands.
+	Data       string   // the message sent with the DATA command.
+	Done       chan struct{}
+}
+
+func NewSMTPServer(t *testing.T) *SMTPServer {
+	t.Helper()
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+	t.Cleanup(func() { l.Close() })
+
+	host, port, _ := net.SplitHostPort(l.Addr().String())
+	portNum, _ := strconv.Atoi(port)
+	s := &SMTPServer{Host: host, Port: portNum, Done: make(chan struct{})}
+	go func() {
+		defer close(s.Done)
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		tp := textproto.NewConn(conn)
+		tp.PrintfLine("220 localhost ESMTP")
+		for {
+			line, err := tp.ReadLine()
+			if err != nil {
+				return
+			}
+			cmd, arg, _ := strings.Cut(line, " ")
+			switch strings.ToUpper(cmd) {
+			case "EHLO", "HELO":
+				tp.PrintfLine("250-localhost")
+				tp.PrintfLine("250 AUTH PLAIN")
+			case "AUTH":
+				s.Auth = arg
+				tp.PrintfLine("235 authenticated")
+			case "MAIL":
+				tp.PrintfLine("250 ok")
+			case "RCPT":
+				s.Recipients = append(s.Recipients, arg)
+				tp.PrintfLine("250 ok")
+			case "DATA":
+				tp.PrintfLine("354 go ahead")
+				data, err := tp.ReadDotBytes()
+				if err != nil {
+					return
+				}
+				s.Data = string(data)
+				tp.PrintfLine("250 queued")
+			case "QUIT":
+				tp.PrintfLine("221 bye")
+				return
+			default:
+				tp.PrintfLine("502 unknown command")
+			}
+		}
+	}()
+	return s
+}
@@ -48,6 +48,27 @@ export class Config extends Message<Config> {
    */
   auth?: Auth;
 
+  /**
+   * how long the history of operations is kept.
+   *
+   * @generated from field: v1.GcPolicy gc_policy = 7;
+   */
+  gcPolicy?: GcPolicy;
+
+  /**
+   * backs up backrest's own state.
+   *
+   * @generated from field: v1.SelfBackup self_backup = 8;
+   */
+  selfBackup?: SelfBackup;
+
+  /**
+   * hooks to run on events of every repo and plan, see skip_global_hooks.
+   *
+   * @generated from field: repeated v1.Hook hooks = 9;
+   */
+  hooks: Hook[] = [];
+
   constructor(data?: PartialMessage<Config>) {
     super();
     proto3.util.initPartial(data, this);
@@ -62,6 +83,9 @@ export class Config extends Message<Config> {
     { no: 3, name: "repos", kind: "message", T: Repo, repeated: true },
     { no: 4, name: "plans", kind: "message", T: Plan, repeated: true },
     { no: 5, name: "auth", kind: "message", T: Auth },
+    { no: 7, name: "gc_policy", kind: "message", T: GcPolicy },
+    { no: 8, name: "self_backup", kind: "message", T: SelfBackup },
+    { no: 9, name: "hooks", kind: "message", T: Hook, repeated: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Config {
@@ -135,12 +159,26 @@ export class Repo extends Message<Repo> {
   hooks: Hook[] = [];
 
   /**
-   * automatically unlock the repo when needed.
+   * automatically remove stale locks from the repo when needed.
    *
    * @generated from field: bool auto_unlock = 8;
    */
   autoUnlock = false;
 
+  /**
+   * locks older than this are stale and removed by auto_unlock, at least 10 minutes. Defaults to 30 minutes.
+   *
+   * @generated from field: int32 stale_lock_age_minutes = 9;
+   */
+  staleLockAgeMinutes = 0;
+
+  /**
+   * names of global hooks not to run for this repo's events.
+   *
+   * @generated from field: repeated string skip_global_hooks = 10;
+   */
+  skipGlobalHooks: string[] = [];
+
   constructor(data?: PartialMessage<Repo>) {
     super();
     proto3.util.initPartial(data, this);
@@ -157,6 +195,8 @@ export class Repo extends Message<Repo> {
     { no: 6, name: "prune_policy", kind: "message", T: PrunePolicy },
     { no: 7, name: "hooks", kind: "message", T: Hook, repeated: true },
     { no: 8, name: "auto_unlock", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 9, name: "stale_lock_age_minutes", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 10, name: "skip_global_hooks", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Repo {
@@ -248,6 +288,20 @@ export class Plan extends Message<Plan> {
    */
   backupFlags: string[] = [];
 
+  /**
+   * overrides the fields of the config's gc policy that are set for this plan's operations.
+   *
+   * @generated from field: v1.GcPolicy gc_policy = 12;
+   */
+  gcPolicy?: GcPolicy;
+
+  /**
+   * names of global hooks not to run for this plan's events.
+   *
+   * @generated from field: repeated string skip_global_hooks = 13;
+   */
+  skipGlobalHooks: string[] = [];
+
   constructor(data?: PartialMessage<Plan>) {
     super();
     proto3.util.initPartial(data, this);
@@ -266,6 +320,8 @@ export class Plan extends Message<Plan> {
     { no: 7, name: "retention", kind: "message", T: RetentionPolicy },
     { no: 8, name: "hooks", kind: "message", T: Hook, repeated: true },
     { no: 10, name: "backup_flags", jsonName: "backup_flags", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 12, name: "gc_policy", kind: "message", T: GcPolicy },
+    { no: 13, name: "skip_global_hooks", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Plan {
@@ -471,6 +527,129 @@ export class RetentionPolicy_TimeBucketedCounts extends Message<RetentionPolicy_
   }
 }
 
+/**
+ * SelfBackup backs up backrest's own state i.e. the operation log, config, auth secret and logs to a repo.
+ * Snapshots are tagged with the reserved plan id _self_backup_, run `backrest -restore-self-backup-repo <uri>` to rebuild the data dir from the latest one.
+ *
+ * @generated from message v1.SelfBackup
+ */
+export class SelfBackup extends Message<SelfBackup> {
+  /**
+   * ID of the repo to back up to, self backup is disabled if empty.
+   *
+   * @generated from field: string repo = 1;
+   */
+  repo = "";
+
+  /**
+   * cron expression describing the backup schedule.
+   *
+   * @generated from field: string cron = 2;
+   */
+  cron = "";
+
+  constructor(data?: PartialMessage<SelfBackup>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.SelfBackup";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "repo", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "cron", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SelfBackup {
+    return new SelfBackup().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SelfBackup {
+    return new SelfBackup().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SelfBackup {
+    return new SelfBackup().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SelfBackup | PlainMessage<SelfBackup> | undefined, b: SelfBackup | PlainMessage<SelfBackup> | undefined): boolean {
+    return proto3.util.equals(SelfBackup, a, b);
+  }
+}
+
+/**
+ * GcPolicy decides when operations are removed from the history. Operations of snapshots that still exist are never removed.
+ *
+ * @generated from message v1.GcPolicy
+ */
+export class GcPolicy extends Message<GcPolicy> {
+  /**
+   * max age of operations, defaults to 30 days.
+   *
+   * @generated from field: int32 max_age_days = 1;
+   */
+  maxAgeDays = 0;
+
+  /**
+   * max age by OperationType name e.g. TYPE_STATS, stats default to 365 days.
+   *
+   * @generated from field: map<string, int32> max_age_days_by_type = 2;
+   */
+  maxAgeDaysByType: { [key: string]: number } = {};
+
+  /**
+   * max number of operations kept per plan, defaults to 1000. Every operation of the plan counts towards the limit, including those that are never removed e.g. of existing snapshots, only the oldest removable operations are removed to meet it.
+   *
+   * @generated from field: int32 max_count = 3;
+   */
+  maxCount = 0;
+
+  /**
+   * failed operations are kept at least this long, 0 to treat them like other operations.
+   *
+   * @generated from field: int32 failed_max_age_days = 4;
+   */
+  failedMaxAgeDays = 0;
+
+  /**
+   * how often garbage collection runs, defaults to 24 hours. Only read from the config's policy.
+   *
+   * @generated from field: int32 interval_hours = 5;
+   */
+  intervalHours = 0;
+
+  constructor(data?: PartialMessage<GcPolicy>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.GcPolicy";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "max_age_days", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 2, name: "max_age_days_by_type", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 5 /* ScalarType.INT32 */} },
+    { no: 3, name: "max_count", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 4, name: "failed_max_age_days", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 5, name: "interval_hours", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GcPolicy {
+    return new GcPolicy().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GcPolicy {
+    return new GcPolicy().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GcPolicy {
+    return new GcPolicy().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GcPolicy | PlainMessage<GcPolicy> | undefined, b: GcPolicy | PlainMessage<GcPolicy> | undefined): boolean {
+    return proto3.util.equals(GcPolicy, a, b);
+  }
+}
+
 /**
  * @generated from message v1.PrunePolicy
  */
@@ -530,11 +709,60 @@ export class PrunePolicy extends Message<PrunePolicy> {
  * @generated from message v1.Hook
  */
 export class Hook extends Message<Hook> {
+  /**
+   * optional, identifies a global hook so that repos and plans can skip it.
+   *
+   * @generated from field: string name = 7;
+   */
+  name = "";
+
   /**
    * @generated from field: repeated v1.Hook.Condition conditions = 1;
    */
   conditions: Hook_Condition[] = [];
 
+  /**
+   * only honoured for conditions that run before an operation e.g. CONDITION_SNAPSHOT_START.
+   *
+   * @generated from field: v1.Hook.OnError on_error = 2;
+   */
+  onError = Hook_OnError.IGNORE;
+
+  /**
+   * timeout of each attempt at running the hook. Defaults to no timeout for hooks run before an operation and to 10 minutes for other hooks, which aren't cancelled with the operation.
+   *
+   * @generated from field: int32 timeout_seconds = 3;
+   */
+  timeoutSeconds = 0;
+
+  /**
+   * attempts after the first when the hook fails. Webhook requests rejected with a 4xx status other than 429 aren't retried.
+   *
+   * @generated from field: int32 retries = 4;
+   */
+  retries = 0;
+
+  /**
+   * delay before the first retry, doubled for each retry after it. Defaults to 5 seconds.
+   *
+   * @generated from field: int32 retry_backoff_seconds = 5;
+   */
+  retryBackoffSeconds = 0;
+
+  /**
+   * run the hook in the background without delaying the operation, on_error is ignored.
+   *
+   * @generated from field: bool async = 6;
+   */
+  async = false;
+
+  /**
+   * limits how often the hook runs, intended for notifications.
+   *
+   * @generated from field: v1.NotificationPolicy notification_policy = 8;
+   */
+  notificationPolicy?: NotificationPolicy;
+
   /**
    * @generated from oneof v1.Hook.action
    */
@@ -574,6 +802,18 @@ export class Hook extends Message<Hook> {
      */
     value: Hook_Shoutrrr;
     case: "actionShoutrrr";
+  } | {
+    /**
+     * @generated from field: v1.Hook.Email action_email = 106;
+     */
+    value: Hook_Email;
+    case: "actionEmail";
+  } | {
+    /**
+     * @generated from field: v1.Hook.Healthcheck action_healthcheck = 107;
+     */
+    value: Hook_Healthcheck;
+    case: "actionHealthcheck";
   } | { case: undefined; value?: undefined } = { case: undefined };
 
   constructor(data?: PartialMessage<Hook>) {
@@ -584,13 +824,22 @@ export class Hook extends Message<Hook> {
   static readonly runtime: typeof proto3 = proto3;
   static readonly typeName = "v1.Hook";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 7, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 1, name: "conditions", kind: "enum", T: proto3.getEnumType(Hook_Condition), repeated: true },
+    { no: 2, name: "on_error", kind: "enum", T: proto3.getEnumType(Hook_OnError) },
+    { no: 3, name: "timeout_seconds", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 4, name: "retries", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 5, name: "retry_backoff_seconds", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 6, name: "async", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 8, name: "notification_policy", kind: "message", T: NotificationPolicy },
     { no: 100, name: "action_command", kind: "message", T: Hook_Command, oneof: "action" },
     { no: 101, name: "action_webhook", kind: "message", T: Hook_Webhook, oneof: "action" },
     { no: 102, name: "action_discord", kind: "message", T: Hook_Discord, oneof: "action" },
     { no: 103, name: "action_gotify", kind: "message", T: Hook_Gotify, oneof: "action" },
     { no: 104, name: "action_slack", kind: "message", T: Hook_Slack, oneof: "action" },
     { no: 105, name: "action_shoutrrr", kind: "message", T: Hook_Shoutrrr, oneof: "action" },
+    { no: 106, name: "action_email", kind: "message", T: Hook_Email, oneof: "action" },
+    { no: 107, name: "action_healthcheck", kind: "message", T: Hook_Healthcheck, oneof: "action" },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Hook {
@@ -646,6 +895,105 @@ export enum Hook_Condition {
    * @generated from enum value: CONDITION_SNAPSHOT_ERROR = 4;
    */
   SNAPSHOT_ERROR = 4,
+
+  /**
+   * any operation completed successfully.
+   *
+   * @generated from enum value: CONDITION_ANY_SUCCESS = 5;
+   */
+  ANY_SUCCESS = 5,
+
+  /**
+   * prune
+   *
+   * prune is about to run, not sent for prunes skipped by the prune policy.
+   *
+   * @generated from enum value: CONDITION_PRUNE_START = 100;
+   */
+  PRUNE_START = 100,
+
+  /**
+   * prune completed.
+   *
+   * @generated from enum value: CONDITION_PRUNE_SUCCESS = 101;
+   */
+  PRUNE_SUCCESS = 101,
+
+  /**
+   * prune failed.
+   *
+   * @generated from enum value: CONDITION_PRUNE_ERROR = 102;
+   */
+  PRUNE_ERROR = 102,
+
+  /**
+   * forget
+   *
+   * forget started.
+   *
+   * @generated from enum value: CONDITION_FORGET_START = 200;
+   */
+  FORGET_START = 200,
+
+  /**
+   * forget completed.
+   *
+   * @generated from enum value: CONDITION_FORGET_SUCCESS = 201;
+   */
+  FORGET_SUCCESS = 201,
+
+  /**
+   * forget failed.
+   *
+   * @generated from enum value: CONDITION_FORGET_ERROR = 202;
+   */
+  FORGET_ERROR = 202,
+
+  /**
+   * restore
+   *
+   * restore started.
+   *
+   * @generated from enum value: CONDITION_RESTORE_START = 300;
+   */
+  RESTORE_START = 300,
+
+  /**
+   * restore completed.
+   *
+   * @generated from enum value: CONDITION_RESTORE_SUCCESS = 301;
+   */
+  RESTORE_SUCCESS = 301,
+
+  /**
+   * restore failed.
+   *
+   * @generated from enum value: CONDITION_RESTORE_ERROR = 302;
+   */
+  RESTORE_ERROR = 302,
+
+  /**
+   * stats
+   *
+   * stats started.
+   *
+   * @generated from enum value: CONDITION_STATS_START = 400;
+   */
+  STATS_START = 400,
+
+  /**
+   * stats completed.
+   *
+   * @generated from enum value: CONDITION_STATS_SUCCESS = 401;
+   */
+  STATS_SUCCESS = 401,
+
+  /**
+   * stats failed.
+   *
+   * @generated from enum value: CONDITION_STATS_ERROR = 402;
+   */
+  STATS_ERROR = 402,
 }
 // Retrieve enum metadata with: proto3.getEnumType(Hook_Condition)
 proto3.util.setEnumType(Hook_Condition, "v1.Hook.Condition", [
@@ -654,6 +1002,53 @@ proto3.util.setEnumType(Hook_Condition, "v1.Hook.Condition", [
   { no: 2, name: "CONDITION_SNAPSHOT_START" },
   { no: 3, name: "CONDITION_SNAPSHOT_END" },
   { no: 4, name: "CONDITION_SNAPSHOT_ERROR" },
+  { no: 5, name: "CONDITION_ANY_SUCCESS" },
+  { no: 100, name: "CONDITION_PRUNE_START" },
+  { no: 101, name: "CONDITION_PRUNE_SUCCESS" },
+  { no: 102, name: "CONDITION_PRUNE_ERROR" },
+  { no: 200, name: "CONDITION_FORGET_START" },
+  { no: 201, name: "CONDITION_FORGET_SUCCESS" },
+  { no: 202, name: "CONDITION_FORGET_ERROR" },
+  { no: 300, name: "CONDITION_RESTORE_START" },
+  { no: 301, name: "CONDITION_RESTORE_SUCCESS" },
+  { no: 302, name: "CONDITION_RESTORE_ERROR" },
+  { no: 400, name: "CONDITION_STATS_START" },
+  { no: 401, name: "CONDITION_STATS_SUCCESS" },
+  { no: 402, name: "CONDITION_STATS_ERROR" },
+]);
+
+/**
+ * OnError decides what happens to the operation that triggered the hook when the hook fails.
+ *
+ * @generated from enum v1.Hook.OnError
+ */
+export enum Hook_OnError {
+  /**
+   * log the failure and continue.
+   *
+   * @generated from enum value: ON_ERROR_IGNORE = 0;
+   */
+  IGNORE = 0,
+
+  /**
+   * fail the operation, hooks after this one are not run.
+   *
+   * @generated from enum value: ON_ERROR_FATAL = 1;
+   */
+  FATAL = 1,
+
+  /**
+   * skip the operation, it is recorded as cancelled without an error. Hooks after this one are not run.
+   *
+   * @generated from enum value: ON_ERROR_CANCEL = 2;
+   */
+  CANCEL = 2,
+}
+// Retrieve enum metadata with: proto3.getEnumType(Hook_OnError)
+proto3.util.setEnumType(Hook_OnError, "v1.Hook.OnError", [
+  { no: 0, name: "ON_ERROR_IGNORE" },
+  { no: 1, name: "ON_ERROR_FATAL" },
+  { no: 2, name: "ON_ERROR_CANCEL" },
 ]);
 
 /**
@@ -665,6 +1060,34 @@ export class Hook_Command extends Message<Hook_Command> {
    */
   command = "";
 
+  /**
+   * exit code that skips the operation as with ON_ERROR_CANCEL whatever on_error is, 0 to disable.
+   *
+   * @generated from field: int32 skip_exit_code = 2;
+   */
+  skipExitCode = 0;
+
+  /**
+   * directory the command runs in, defaults to backrest's working directory.
+   *
+   * @generated from field: string working_dir = 3;
+   */
+  workingDir = "";
+
+  /**
+   * environment variables set in addition to backrest's environment and the BACKREST_ variables.
+   *
+   * @generated from field: map<string, string> env = 4;
+   */
+  env: { [key: string]: string } = {};
+
+  /**
+   * name of the user to run the command as, requires backrest to run as root. Not supported on windows.
+   *
+   * @generated from field: string run_as_user = 5;
+   */
+  runAsUser = "";
+
   constructor(data?: PartialMessage<Hook_Command>) {
     super();
     proto3.util.initPartial(data, this);
@@ -674,6 +1097,10 @@ export class Hook_Command extends Message<Hook_Command> {
   static readonly typeName = "v1.Hook.Command";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "command", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "skip_exit_code", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "working_dir", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "env", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
+    { no: 5, name: "run_as_user", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Hook_Command {
@@ -702,6 +1129,63 @@ export class Hook_Webhook extends Message<Hook_Webhook> {
    */
   webhookUrl = "";
 
+  /**
+   * @generated from field: v1.Hook.Webhook.Method method = 2;
+   */
+  method = Hook_Webhook_Method.UNKNOWN;
+
+  /**
+   * extra request headers.
+   *
+   * @generated from field: map<string, string> headers = 3;
+   */
+  headers: { [key: string]: string } = {};
+
+  /**
+   * template for the request body, not sent for GET requests.
+   *
+   * @generated from field: string template = 4;
+   */
+  template = "";
+
+  /**
+   * content type of the body, defaults to text/plain.
+   *
+   * @generated from field: string content_type = 5;
+   */
+  contentType = "";
+
+  /**
+   * @generated from oneof v1.Hook.Webhook.auth
+   */
+  auth: {
+    /**
+     * @generated from field: v1.Hook.Webhook.BasicAuth basic_auth = 6;
+     */
+    value: Hook_Webhook_BasicAuth;
+    case: "basicAuth";
+  } | {
+    /**
+     * @generated from field: string bearer_token = 7;
+     */
+    value: string;
+    case: "bearerToken";
+  } | { case: undefined; value?: undefined } = { case: undefined };
+
+  /**
+   * accept any server certificate.
+   *
+   * @generated from field: bool skip_tls_verify = 8;
+   */
+  skipTlsVerify = false;
+
+  /**
+   * PEM file of additional CAs to trust for the server certificate.
+   *
+   * @generated from field: string ca_cert_path = 9;
+   */
+  caCertPath = "";
+
   constructor(data?: PartialMessage<Hook_Webhook>) {
     super();
     proto3.util.initPartial(data, this);
@@ -711,6 +1195,14 @@ export class Hook_Webhook extends Message<Hook_Webhook> {
   static readonly typeName = "v1.Hook.Webhook";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "webhook_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "method", kind: "enum", T: proto3.getEnumType(Hook_Webhook_Method) },
+    { no: 3, name: "headers", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
+    { no: 4, name: "template", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "content_type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "basic_auth", kind: "message", T: Hook_Webhook_BasicAuth, oneof: "auth" },
+    { no: 7, name: "bearer_token", kind: "scalar", T: 9 /* ScalarType.STRING */, oneof: "auth" },
+    { no: 8, name: "skip_tls_verify", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 9, name: "ca_cert_path", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Hook_Webhook {
@@ -730,6 +1222,89 @@ export class Hook_Webhook extends Message<Hook_Webhook> {
   }
 }
 
+/**
+ * @generated from enum v1.Hook.Webhook.Method
+ */
+export enum Hook_Webhook_Method {
+  /**
+   * POST
+   *
+   * @generated from enum value: UNKNOWN = 0;
+   */
+  UNKNOWN = 0,
+
+  /**
+   * @generated from enum value: GET = 1;
+   */
+  GET = 1,
+
+  /**
+   * @generated from enum value: POST = 2;
+   */
+  POST = 2,
+
+  /**
+   * @generated from enum value: PUT = 3;
+   */
+  PUT = 3,
+
+  /**
+   * @generated from enum value: PATCH = 4;
+   */
+  PATCH = 4,
+}
+// Retrieve enum metadata with: proto3.getEnumType(Hook_Webhook_Method)
+proto3.util.setEnumType(Hook_Webhook_Method, "v1.Hook.Webhook.Method", [
+  { no: 0, name: "UNKNOWN" },
+  { no: 1, name: "GET" },
+  { no: 2, name: "POST" },
+  { no: 3, name: "PUT" },
+  { no: 4, name: "PATCH" },
+]);
+
+/**
+ * @generated from message v1.Hook.Webhook.BasicAuth
+ */
+export class Hook_Webhook_BasicAuth extends Message<Hook_Webhook_BasicAuth> {
+  /**
+   * @generated from field: string username = 1;
+   */
+  username = "";
+
+  /**
+   * @generated from field: string password = 2;
+   */
+  password = "";
+
+  constructor(data?: PartialMessage<Hook_Webhook_BasicAuth>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.Hook.Webhook.BasicAuth";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "username", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "password", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Hook_Webhook_BasicAuth {
+    return new Hook_Webhook_BasicAuth().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Hook_Webhook_BasicAuth {
+    return new Hook_Webhook_BasicAuth().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Hook_Webhook_BasicAuth {
+    return new Hook_Webhook_BasicAuth().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Hook_Webhook_BasicAuth | PlainMessage<Hook_Webhook_BasicAuth> | undefined, b: Hook_Webhook_BasicAuth | PlainMessage<Hook_Webhook_BasicAuth> | undefined): boolean {
+    return proto3.util.equals(Hook_Webhook_BasicAuth, a, b);
+  }
+}
+
 /**
  * @generated from message v1.Hook.Discord
  */
@@ -922,6 +1497,337 @@ export class Hook_Shoutrrr extends Message<Hook_Shoutrrr> {
   }
 }
 
+/**
+ * @generated from message v1.Hook.Email
+ */
+export class Hook_Email extends Message<Hook_Email> {
+  /**
+   * the SMTP server or relay.
+   *
+   * @generated from field: string host = 1;
+   */
+  host = "";
+
+  /**
+   * defaults to 465 for TLS, 25 for NONE and 587 otherwise.
+   *
+   * @generated from field: int32 port = 2;
+   */
+  port = 0;
+
+  /**
+   * @generated from field: v1.Hook.Email.Security security = 3;
+   */
+  security = Hook_Email_Security.UNKNOWN;
+
+  /**
+   * accept any server certificate.
+   *
+   * @generated from field: bool skip_tls_verify = 4;
+   */
+  skipTlsVerify = false;
+
+  /**
+   * authenticate with PLAIN auth if set, requires an encrypted connection unless the server is localhost.
+   *
+   * @generated from field: string username = 5;
+   */
+  username = "";
+
+  /**
+   * @generated from field: string password = 6;
+   */
+  password = "";
+
+  /**
+   * @generated from field: string from = 7;
+   */
+  from = "";
+
+  /**
+   * @generated from field: repeated string to = 8;
+   */
+  to: string[] = [];
+
+  /**
+   * @generated from field: repeated string cc = 9;
+   */
+  cc: string[] = [];
+
+  /**
+   * template for the subject.
+   *
+   * @generated from field: string subject_template = 10;
+   */
+  subjectTemplate = "";
+
+  /**
+   * template for the body.
+   *
+   * @generated from field: string template = 11;
+   */
+  template = "";
+
+  /**
+   * the body template renders HTML, ignored if template is empty.
+   *
+   * @generated from field: bool html = 12;
+   */
+  html = false;
+
+  /**
+   * attach the log of the operation that triggered the hook if it has one.
+   *
+   * @generated from field: bool attach_log = 13;
+   */
+  attachLog = false;
+
+  constructor(data?: PartialMessage<Hook_Email>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.Hook.Email";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "host", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "port", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "security", kind: "enum", T: proto3.getEnumType(Hook_Email_Security) },
+    { no: 4, name: "skip_tls_verify", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 5, name: "username", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "password", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "from", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 8, name: "to", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 9, name: "cc", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 10, name: "subject_template", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 11, name: "template", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 12, name: "html", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 13, name: "attach_log", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Hook_Email {
+    return new Hook_Email().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Hook_Email {
+    return new Hook_Email().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Hook_Email {
+    return new Hook_Email().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Hook_Email | PlainMessage<Hook_Email> | undefined, b: Hook_Email | PlainMessage<Hook_Email> | undefined): boolean {
+    return proto3.util.equals(Hook_Email, a, b);
+  }
+}
+
+/**
+ * @generated from enum v1.Hook.Email.Security
+ */
+export enum Hook_Email_Security {
+  /**
+   * STARTTLS if the server offers it.
+   *
+   * @generated from enum value: UNKNOWN = 0;
+   */
+  UNKNOWN = 0,
+
+  /**
+   * require STARTTLS.
+   *
+   * @generated from enum value: STARTTLS = 1;
+   */
+  STARTTLS = 1,
+
+  /**
+   * connect with TLS, typically on port 465.
+   *
+   * @generated from enum value: TLS = 2;
+   */
+  TLS = 2,
+
+  /**
+   * never encrypt the connection.
+   *
+   * @generated from enum value: NONE = 3;
+   */
+  NONE = 3,
+}
+// Retrieve enum metadata with: proto3.getEnumType(Hook_Email_Security)
+proto3.util.setEnumType(Hook_Email_Security, "v1.Hook.Email.Security", [
+  { no: 0, name: "UNKNOWN" },
+  { no: 1, name: "STARTTLS" },
+  { no: 2, name: "TLS" },
+  { no: 3, name: "NONE" },
+]);
+
+/**
+ * Healthcheck pings a heartbeat monitor e.g. healthchecks.io or an Uptime Kuma push monitor. Start events ping the url with
+ * start_suffix, successes with success_suffix and errors with fail_suffix. The summary of the event and the tail of the
+ * operation's log are sent as the body.
+ *
+ * @generated from message v1.Hook.Healthcheck
+ */
+export class Hook_Healthcheck extends Message<Hook_Healthcheck> {
+  /**
+   * the check's ping url e.g. https://hc-ping.com/<uuid> or a self-hosted instance's.
+   *
+   * @generated from field: string ping_url = 1;
+   */
+  pingUrl = "";
+
+  /**
+   * defaults to "/start".
+   *
+   * @generated from field: string start_suffix = 2;
+   */
+  startSuffix = "";
+
+  /**
+   * defaults to no suffix.
+   *
+   * @generated from field: string success_suffix = 3;
+   */
+  successSuffix = "";
+
+  /**
+   * defaults to "/fail".
+   *
+   * @generated from field: string fail_suffix = 4;
+   */
+  failSuffix = "";
+
+  /**
+   * defaults to POST, GET pings send no body.
+   *
+   * @generated from field: v1.Hook.Webhook.Method method = 5;
+   */
+  method = Hook_Webhook_Method.UNKNOWN;
+
+  /**
+   * the body is truncated to this size keeping the end of the log, defaults to 100000.
+   *
+   * @generated from field: int32 max_body_bytes = 6;
+   */
+  maxBodyBytes = 0;
+
+  /**
+   * accept any server certificate.
+   *
+   * @generated from field: bool skip_tls_verify = 7;
+   */
+  skipTlsVerify = false;
+
+  /**
+   * PEM file of additional CAs to trust for the server certificate.
+   *
+   * @generated from field: string ca_cert_path = 8;
+   */
+  caCertPath = "";
+
+  constructor(data?: PartialMessage<Hook_Healthcheck>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.Hook.Healthcheck";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "ping_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "start_suffix", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "success_suffix", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "fail_suffix", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "method", kind: "enum", T: proto3.getEnumType(Hook_Webhook_Method) },
+    { no: 6, name: "max_body_bytes", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 7, name: "skip_tls_verify", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 8, name: "ca_cert_path", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Hook_Healthcheck {
+    return new Hook_Healthcheck().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Hook_Healthcheck {
+    return new Hook_Healthcheck().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Hook_Healthcheck {
+    return new Hook_Healthcheck().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Hook_Healthcheck | PlainMessage<Hook_Healthcheck> | undefined, b: Hook_Healthcheck | PlainMessage<Hook_Healthcheck> | undefined): boolean {
+    return proto3.util.equals(Hook_Healthcheck, a, b);
+  }
+}
+
+/**
+ * NotificationPolicy limits how often a hook runs for a plan, or for a repo's operations that don't belong to a plan. Suppressed
+ * runs are recorded in the operation log.
+ *
+ * @generated from message v1.NotificationPolicy
+ */
+export class NotificationPolicy extends Message<NotificationPolicy> {
+  /**
+   * only run when the outcome of the operation differs from the previous operation of the same plan and type, i.e. on the first
+   * failure and on recovery. Events that don't report an outcome e.g. CONDITION_SNAPSHOT_START are not affected.
+   *
+   * @generated from field: bool on_change_only = 1;
+   */
+  onChangeOnly = false;
+
+  /**
+   * run at most this many times per period, 0 for no limit.
+   *
+   * @generated from field: int32 max_per_period = 2;
+   */
+  maxPerPeriod = 0;
+
+  /**
+   * length of the rate limit period, defaults to 60 minutes.
+   *
+   * @generated from field: int32 period_minutes = 3;
+   */
+  periodMinutes = 0;
+
+  /**
+   * pass the runs suppressed since the hook last ran to its next run as .Suppressed.
+   *
+   * @generated from field: bool digest = 4;
+   */
+  digest = false;
+
+  constructor(data?: PartialMessage<NotificationPolicy>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.NotificationPolicy";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "on_change_only", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 2, name: "max_per_period", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "period_minutes", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 4, name: "digest", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): NotificationPolicy {
+    return new NotificationPolicy().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): NotificationPolicy {
+    return new NotificationPolicy().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): NotificationPolicy {
+    return new NotificationPolicy().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: NotificationPolicy | PlainMessage<NotificationPolicy> | undefined, b: NotificationPolicy | PlainMessage<NotificationPolicy> | undefined): boolean {
+    return proto3.util.equals(NotificationPolicy, a, b);
+  }
+}
+
 /**
  * @generated from message v1.Auth
  */
@@ -5,8 +5,8 @@
 
 import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
 import { Message, proto3, protoInt64 } from "@bufbuild/protobuf";
-import { BackupProgressEntry, BackupProgressError, RepoStats, ResticSnapshot, RestoreProgressEntry } from "./restic_pb.js";
-import { RetentionPolicy } from "./config_pb.js";
+import { BackupProgressEntry, BackupProgressError, PruneStats, RepoStats, ResticSnapshot, RestoreProgressEntry } from "./restic_pb.js";
+import { Hook_Condition, RetentionPolicy } from "./config_pb.js";
 
 /**
  * OperationEventType indicates whether the operation was created or updated
@@ -33,6 +33,13 @@ export enum OperationEventType {
    * @generated from enum value: EVENT_DELETED = 3;
    */
   EVENT_DELETED = 3,
+
+  /**
+   * the events after the requested seq are no longer available, the client must refetch operations. seq is the current seq.
+   *
+   * @generated from enum value: EVENT_RESYNC_REQUIRED = 4;
+   */
+  EVENT_RESYNC_REQUIRED = 4,
 }
 // Retrieve enum metadata with: proto3.getEnumType(OperationEventType)
 proto3.util.setEnumType(OperationEventType, "v1.OperationEventType", [
@@ -40,6 +47,65 @@ proto3.util.setEnumType(OperationEventType, "v1.OperationEventType", [
   { no: 1, name: "EVENT_CREATED" },
   { no: 2, name: "EVENT_UPDATED" },
   { no: 3, name: "EVENT_DELETED" },
+  { no: 4, name: "EVENT_RESYNC_REQUIRED" },
+]);
+
+/**
+ * OperationType identifies the kind of an operation i.e. which field of Operation.op is set.
+ *
+ * @generated from enum v1.OperationType
+ */
+export enum OperationType {
+  /**
+   * @generated from enum value: TYPE_UNKNOWN = 0;
+   */
+  TYPE_UNKNOWN = 0,
+
+  /**
+   * @generated from enum value: TYPE_BACKUP = 1;
+   */
+  TYPE_BACKUP = 1,
+
+  /**
+   * @generated from enum value: TYPE_INDEX_SNAPSHOT = 2;
+   */
+  TYPE_INDEX_SNAPSHOT = 2,
+
+  /**
+   * @generated from enum value: TYPE_FORGET = 3;
+   */
+  TYPE_FORGET = 3,
+
+  /**
+   * @generated from enum value: TYPE_PRUNE = 4;
+   */
+  TYPE_PRUNE = 4,
+
+  /**
+   * @generated from enum value: TYPE_RESTORE = 5;
+   */
+  TYPE_RESTORE = 5,
+
+  /**
+   * @generated from enum value: TYPE_STATS = 6;
+   */
+  TYPE_STATS = 6,
+
+  /**
+   * @generated from enum value: TYPE_RUN_HOOK = 7;
+   */
+  TYPE_RUN_HOOK = 7,
+}
+// Retrieve enum metadata with: proto3.getEnumType(OperationType)
+proto3.util.setEnumType(OperationType, "v1.OperationType", [
+  { no: 0, name: "TYPE_UNKNOWN" },
+  { no: 1, name: "TYPE_BACKUP" },
+  { no: 2, name: "TYPE_INDEX_SNAPSHOT" },
+  { no: 3, name: "TYPE_FORGET" },
+  { no: 4, name: "TYPE_PRUNE" },
+  { no: 5, name: "TYPE_RESTORE" },
+  { no: 6, name: "TYPE_STATS" },
+  { no: 7, name: "TYPE_RUN_HOOK" },
 ]);
 
 /**
@@ -123,6 +189,20 @@ export class OperationList extends Message<OperationList> {
    */
   operations: Operation[] = [];
 
+  /**
+   * set if more operations match the query, pass as the cursor of the next request to fetch them.
+   *
+   * @generated from field: int64 next_cursor = 2;
+   */
+  nextCursor = protoInt64.zero;
+
+  /**
+   * seq of the most recent event before the operations were read, pass as the since_seq of GetOperationEvents to receive the changes made since.
+   *
+   * @generated from field: int64 seq = 3;
+   */
+  seq = protoInt64.zero;
+
   constructor(data?: PartialMessage<OperationList>) {
     super();
     proto3.util.initPartial(data, this);
@@ -132,6 +212,8 @@ export class OperationList extends Message<OperationList> {
   static readonly typeName = "v1.OperationList";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "operations", kind: "message", T: Operation, repeated: true },
+    { no: 2, name: "next_cursor", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 3, name: "seq", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): OperationList {
@@ -306,6 +388,53 @@ export class Operation extends Message<Operation> {
   }
 }
 
+/**
+ * ExportedOperation is an operation as written to an operation log export, one per line of newline delimited protojson.
+ *
+ * @generated from message v1.ExportedOperation
+ */
+export class ExportedOperation extends Message<ExportedOperation> {
+  /**
+   * @generated from field: v1.Operation operation = 1;
+   */
+  operation?: Operation;
+
+  /**
+   * contents of the logs referenced by the operation keyed by logref, set if the export includes logs.
+   *
+   * @generated from field: map<string, bytes> logs = 2;
+   */
+  logs: { [key: string]: Uint8Array } = {};
+
+  constructor(data?: PartialMessage<ExportedOperation>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.ExportedOperation";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "operation", kind: "message", T: Operation },
+    { no: 2, name: "logs", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 12 /* ScalarType.BYTES */} },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ExportedOperation {
+    return new ExportedOperation().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ExportedOperation {
+    return new ExportedOperation().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ExportedOperation {
+    return new ExportedOperation().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ExportedOperation | PlainMessage<ExportedOperation> | undefined, b: ExportedOperation | PlainMessage<ExportedOperation> | undefined): boolean {
+    return proto3.util.equals(ExportedOperation, a, b);
+  }
+}
+
 /**
  * OperationEvent is used in the wireformat to stream operation changes to clients
  *
@@ -322,6 +451,20 @@ export class OperationEvent extends Message<OperationEvent> {
    */
   operation?: Operation;
 
+  /**
+   * sequence number of the change, increases by one with every change to the log.
+   *
+   * @generated from field: int64 seq = 3;
+   */
+  seq = protoInt64.zero;
+
+  /**
+   * progress of an in progress operation that isn't persisted yet, seq is not set and a reconnecting client will not receive it again.
+   *
+   * @generated from field: bool transient = 4;
+   */
+  transient = false;
+
   constructor(data?: PartialMessage<OperationEvent>) {
     super();
     proto3.util.initPartial(data, this);
@@ -332,6 +475,8 @@ export class OperationEvent extends Message<OperationEvent> {
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "type", kind: "enum", T: proto3.getEnumType(OperationEventType) },
     { no: 2, name: "operation", kind: "message", T: Operation },
+    { no: 3, name: "seq", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 4, name: "transient", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): OperationEvent {
@@ -509,6 +654,20 @@ export class OperationPrune extends Message<OperationPrune> {
    */
   output = "";
 
+  /**
+   * statistics parsed from the output of the prune.
+   *
+   * @generated from field: v1.PruneStats stats = 2;
+   */
+  stats?: PruneStats;
+
+  /**
+   * true if a dry run found the unused space within the repo's prune policy so the prune was not run.
+   *
+   * @generated from field: bool skipped = 3;
+   */
+  skipped = false;
+
   constructor(data?: PartialMessage<OperationPrune>) {
     super();
     proto3.util.initPartial(data, this);
@@ -518,6 +677,8 @@ export class OperationPrune extends Message<OperationPrune> {
   static readonly typeName = "v1.OperationPrune";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "output", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "stats", kind: "message", T: PruneStats },
+    { no: 3, name: "skipped", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): OperationPrune {
@@ -647,6 +808,20 @@ export class OperationRunHook extends Message<OperationRunHook> {
    */
   outputLogref = "";
 
+  /**
+   * the event the hook ran for.
+   *
+   * @generated from field: v1.Hook.Condition condition = 3;
+   */
+  condition = Hook_Condition.UNKNOWN;
+
+  /**
+   * the hook's notification policy suppressed it, display_message says why.
+   *
+   * @generated from field: bool suppressed = 4;
+   */
+  suppressed = false;
+
   constructor(data?: PartialMessage<OperationRunHook>) {
     super();
     proto3.util.initPartial(data, this);
@@ -657,6 +832,8 @@ export class OperationRunHook extends Message<OperationRunHook> {
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 2, name: "output_logref", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "condition", kind: "enum", T: proto3.getEnumType(Hook_Condition) },
+    { no: 4, name: "suppressed", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): OperationRunHook {
@@ -559,3 +559,262 @@ export class RepoStats extends Message<RepoStats> {
   }
 }
 
+/**
+ * PruneStats summarizes the space reclaimed by a prune, parsed from restic's prune output.
+ *
+ * @generated from message v1.PruneStats
+ */
+export class PruneStats extends Message<PruneStats> {
+  /**
+   * blobs in the repo before the prune.
+   *
+   * @generated from field: int64 blobs_total = 1;
+   */
+  blobsTotal = protoInt64.zero;
+
+  /**
+   * bytes in the repo before the prune.
+   *
+   * @generated from field: int64 bytes_total = 2;
+   */
+  bytesTotal = protoInt64.zero;
+
+  /**
+   * unused blobs before the prune.
+   *
+   * @generated from field: int64 blobs_unused = 3;
+   */
+  blobsUnused = protoInt64.zero;
+
+  /**
+   * unused bytes before the prune.
+   *
+   * @generated from field: int64 bytes_unused = 4;
+   */
+  bytesUnused = protoInt64.zero;
+
+  /**
+   * blobs rewritten into new packs.
+   *
+   * @generated from field: int64 blobs_repacked = 5;
+   */
+  blobsRepacked = protoInt64.zero;
+
+  /**
+   * bytes rewritten into new packs.
+   *
+   * @generated from field: int64 bytes_repacked = 6;
+   */
+  bytesRepacked = protoInt64.zero;
+
+  /**
+   * blobs removed, either deleted outright or dropped while repacking.
+   *
+   * @generated from field: int64 blobs_removed = 7;
+   */
+  blobsRemoved = protoInt64.zero;
+
+  /**
+   * bytes freed by the prune.
+   *
+   * @generated from field: int64 bytes_removed = 8;
+   */
+  bytesRemoved = protoInt64.zero;
+
+  /**
+   * blobs remaining after the prune.
+   *
+   * @generated from field: int64 blobs_remaining = 9;
+   */
+  blobsRemaining = protoInt64.zero;
+
+  /**
+   * bytes remaining after the prune.
+   *
+   * @generated from field: int64 bytes_remaining = 10;
+   */
+  bytesRemaining = protoInt64.zero;
+
+  /**
+   * unused bytes remaining after the prune.
+   *
+   * @generated from field: int64 bytes_unused_after = 11;
+   */
+  bytesUnusedAfter = protoInt64.zero;
+
+  /**
+   * unused bytes remaining as a percentage of the remaining size.
+   *
+   * @generated from field: double percent_unused_after = 12;
+   */
+  percentUnusedAfter = 0;
+
+  /**
+   * @generated from field: int64 packs_repacked = 13;
+   */
+  packsRepacked = protoInt64.zero;
+
+  /**
+   * @generated from field: int64 packs_removed = 14;
+   */
+  packsRemoved = protoInt64.zero;
+
+  constructor(data?: PartialMessage<PruneStats>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.PruneStats";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "blobs_total", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "bytes_total", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 3, name: "blobs_unused", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 4, name: "bytes_unused", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 5, name: "blobs_repacked", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 6, name: "bytes_repacked", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 7, name: "blobs_removed", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 8, name: "bytes_removed", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 9, name: "blobs_remaining", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 10, name: "bytes_remaining", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 11, name: "bytes_unused_after", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 12, name: "percent_unused_after", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 13, name: "packs_repacked", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 14, name: "packs_removed", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PruneStats {
+    return new PruneStats().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PruneStats {
+    return new PruneStats().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PruneStats {
+    return new PruneStats().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PruneStats | PlainMessage<PruneStats> | undefined, b: PruneStats | PlainMessage<PruneStats> | undefined): boolean {
+    return proto3.util.equals(PruneStats, a, b);
+  }
+}
+
+/**
+ * ResticLock represents a lock held on a restic repo.
+ *
+ * @generated from message v1.ResticLock
+ */
+export class ResticLock extends Message<ResticLock> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * time the lock was created or last refreshed.
+   *
+   * @generated from field: int64 unix_time_ms = 2;
+   */
+  unixTimeMs = protoInt64.zero;
+
+  /**
+   * @generated from field: bool exclusive = 3;
+   */
+  exclusive = false;
+
+  /**
+   * @generated from field: string hostname = 4;
+   */
+  hostname = "";
+
+  /**
+   * @generated from field: string username = 5;
+   */
+  username = "";
+
+  /**
+   * @generated from field: int64 pid = 6;
+   */
+  pid = protoInt64.zero;
+
+  /**
+   * true if the lock would be removed by auto unlock.
+   *
+   * @generated from field: bool stale = 7;
+   */
+  stale = false;
+
+  constructor(data?: PartialMessage<ResticLock>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.ResticLock";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "unix_time_ms", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 3, name: "exclusive", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 4, name: "hostname", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "username", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "pid", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 7, name: "stale", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ResticLock {
+    return new ResticLock().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ResticLock {
+    return new ResticLock().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ResticLock {
+    return new ResticLock().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ResticLock | PlainMessage<ResticLock> | undefined, b: ResticLock | PlainMessage<ResticLock> | undefined): boolean {
+    return proto3.util.equals(ResticLock, a, b);
+  }
+}
+
+/**
+ * ResticLockList represents a list of locks held on a restic repo.
+ *
+ * @generated from message v1.ResticLockList
+ */
+export class ResticLockList extends Message<ResticLockList> {
+  /**
+   * @generated from field: repeated v1.ResticLock locks = 1;
+   */
+  locks: ResticLock[] = [];
+
+  constructor(data?: PartialMessage<ResticLockList>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.ResticLockList";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "locks", kind: "message", T: ResticLock, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ResticLockList {
+    return new ResticLockList().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ResticLockList {
+    return new ResticLockList().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ResticLockList {
+    return new ResticLockList().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ResticLockList | PlainMessage<ResticLockList> | undefined, b: ResticLockList | PlainMessage<ResticLockList> | undefined): boolean {
+    return proto3.util.equals(ResticLockList, a, b);
+  }
+}
+
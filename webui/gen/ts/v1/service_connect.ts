@@ -5,10 +5,10 @@
 
 import { Empty, MethodKind } from "@bufbuild/protobuf";
 import { Config, Repo } from "./config_pb.js";
-import { OperationEvent, OperationList } from "./operations_pb.js";
-import { ClearHistoryRequest, ForgetRequest, GetOperationsRequest, ListSnapshotFilesRequest, ListSnapshotFilesResponse, ListSnapshotsRequest, LogDataRequest, RestoreSnapshotRequest } from "./service_pb.js";
-import { ResticSnapshotList } from "./restic_pb.js";
+import { ClearHistoryRequest, ExportOperationsRequest, ForgetRequest, GarbageCollectionResult, GetOperationEventsRequest, GetOperationsRequest, GetPlanMetricsRequest, ImportOperationsRequest, ListSnapshotFilesRequest, ListSnapshotFilesResponse, ListSnapshotsRequest, LogDataRequest, PlanMetrics, RestoreSnapshotRequest, TestHookRequest, TestHookResponse } from "./service_pb.js";
+import { ExportedOperation, OperationEvent, OperationList } from "./operations_pb.js";
 import { BytesValue, Int64Value, StringList, StringValue } from "../types/value_pb.js";
+import { ResticLockList, ResticSnapshotList } from "./restic_pb.js";
 
 /**
  * @generated from service v1.Backrest
@@ -44,11 +44,13 @@ export const Backrest = {
       kind: MethodKind.Unary,
     },
     /**
+     * GetOperationEvents streams changes to the operation log. If since_seq is set the changes after it are replayed first.
+     *
      * @generated from rpc v1.Backrest.GetOperationEvents
      */
     getOperationEvents: {
       name: "GetOperationEvents",
-      I: Empty,
+      I: GetOperationEventsRequest,
       O: OperationEvent,
       kind: MethodKind.ServerStreaming,
     },
@@ -61,6 +63,28 @@ export const Backrest = {
       O: OperationList,
       kind: MethodKind.Unary,
     },
+    /**
+     * ExportOperations streams the operations matching the request ordered by id, in the format of an operation log export.
+     *
+     * @generated from rpc v1.Backrest.ExportOperations
+     */
+    exportOperations: {
+      name: "ExportOperations",
+      I: ExportOperationsRequest,
+      O: ExportedOperation,
+      kind: MethodKind.ServerStreaming,
+    },
+    /**
+     * ImportOperations adds the streamed operations to the log with new ids and returns the number of operations imported.
+     *
+     * @generated from rpc v1.Backrest.ImportOperations
+     */
+    importOperations: {
+      name: "ImportOperations",
+      I: ImportOperationsRequest,
+      O: Int64Value,
+      kind: MethodKind.ClientStreaming,
+    },
     /**
      * @generated from rpc v1.Backrest.ListSnapshots
      */
@@ -145,6 +169,17 @@ export const Backrest = {
       O: Empty,
       kind: MethodKind.Unary,
     },
+    /**
+     * GetRepoLocks lists the locks held on the repo and who holds them. It accepts a repo id.
+     *
+     * @generated from rpc v1.Backrest.GetRepoLocks
+     */
+    getRepoLocks: {
+      name: "GetRepoLocks",
+      I: StringValue,
+      O: ResticLockList,
+      kind: MethodKind.Unary,
+    },
     /**
      * Stats runs 'restic stats` on the repository and appends the results to the operations log.
      *
@@ -189,6 +224,40 @@ export const Backrest = {
       O: Empty,
       kind: MethodKind.Unary,
     },
+    /**
+     * RunGarbageCollection removes old operations from the history according to the gc policy and returns what was removed.
+     *
+     * @generated from rpc v1.Backrest.RunGarbageCollection
+     */
+    runGarbageCollection: {
+      name: "RunGarbageCollection",
+      I: Empty,
+      O: GarbageCollectionResult,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * GetPlanMetrics returns the plan's backup metrics rolled up into daily or weekly buckets.
+     *
+     * @generated from rpc v1.Backrest.GetPlanMetrics
+     */
+    getPlanMetrics: {
+      name: "GetPlanMetrics",
+      I: GetPlanMetricsRequest,
+      O: PlanMetrics,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * TestHook runs a hook once for a condition with variables built from the plan's most recent operations and returns what it
+     * sent. The run is not recorded in the operation history.
+     *
+     * @generated from rpc v1.Backrest.TestHook
+     */
+    testHook: {
+      name: "TestHook",
+      I: TestHookRequest,
+      O: TestHookResponse,
+      kind: MethodKind.Unary,
+    },
     /**
      * PathAutocomplete provides path autocompletion options for a given filesystem path.
      *
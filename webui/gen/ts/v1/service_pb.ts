@@ -5,6 +5,36 @@
 
 import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
 import { Message, proto3, protoInt64 } from "@bufbuild/protobuf";
+import { Hook, Hook_Condition } from "./config_pb.js";
+import { ExportedOperation, OperationStatus, OperationType } from "./operations_pb.js";
+
+/**
+ * MetricsBucketSize is the period covered by a MetricsBucket, buckets are aligned to UTC days and to weeks starting on Monday.
+ *
+ * @generated from enum v1.MetricsBucketSize
+ */
+export enum MetricsBucketSize {
+  /**
+   * @generated from enum value: BUCKET_SIZE_UNKNOWN = 0;
+   */
+  BUCKET_SIZE_UNKNOWN = 0,
+
+  /**
+   * @generated from enum value: BUCKET_SIZE_DAY = 1;
+   */
+  BUCKET_SIZE_DAY = 1,
+
+  /**
+   * @generated from enum value: BUCKET_SIZE_WEEK = 2;
+   */
+  BUCKET_SIZE_WEEK = 2,
+}
+// Retrieve enum metadata with: proto3.getEnumType(MetricsBucketSize)
+proto3.util.setEnumType(MetricsBucketSize, "v1.MetricsBucketSize", [
+  { no: 0, name: "BUCKET_SIZE_UNKNOWN" },
+  { no: 1, name: "BUCKET_SIZE_DAY" },
+  { no: 2, name: "BUCKET_SIZE_WEEK" },
+]);
 
 /**
  * @generated from message v1.ClearHistoryRequest
@@ -61,6 +91,406 @@ export class ClearHistoryRequest extends Message<ClearHistoryRequest> {
   }
 }
 
+/**
+ * @generated from message v1.GarbageCollectionResult
+ */
+export class GarbageCollectionResult extends Message<GarbageCollectionResult> {
+  /**
+   * ids of the removed operations.
+   *
+   * @generated from field: repeated int64 removed_ids = 1;
+   */
+  removedIds: bigint[] = [];
+
+  /**
+   * number of removed operations by plan id.
+   *
+   * @generated from field: map<string, int64> removed_by_plan = 2;
+   */
+  removedByPlan: { [key: string]: bigint } = {};
+
+  /**
+   * number of removed operations by OperationType name.
+   *
+   * @generated from field: map<string, int64> removed_by_type = 3;
+   */
+  removedByType: { [key: string]: bigint } = {};
+
+  constructor(data?: PartialMessage<GarbageCollectionResult>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.GarbageCollectionResult";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "removed_ids", kind: "scalar", T: 3 /* ScalarType.INT64 */, repeated: true },
+    { no: 2, name: "removed_by_plan", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 3 /* ScalarType.INT64 */} },
+    { no: 3, name: "removed_by_type", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 3 /* ScalarType.INT64 */} },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GarbageCollectionResult {
+    return new GarbageCollectionResult().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GarbageCollectionResult {
+    return new GarbageCollectionResult().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GarbageCollectionResult {
+    return new GarbageCollectionResult().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GarbageCollectionResult | PlainMessage<GarbageCollectionResult> | undefined, b: GarbageCollectionResult | PlainMessage<GarbageCollectionResult> | undefined): boolean {
+    return proto3.util.equals(GarbageCollectionResult, a, b);
+  }
+}
+
+/**
+ * @generated from message v1.GetPlanMetricsRequest
+ */
+export class GetPlanMetricsRequest extends Message<GetPlanMetricsRequest> {
+  /**
+   * @generated from field: string plan_id = 1;
+   */
+  planId = "";
+
+  /**
+   * defaults to BUCKET_SIZE_DAY.
+   *
+   * @generated from field: v1.MetricsBucketSize bucket_size = 2;
+   */
+  bucketSize = MetricsBucketSize.BUCKET_SIZE_UNKNOWN;
+
+  /**
+   * buckets that start at or after this time.
+   *
+   * @generated from field: int64 start_time_ms = 3;
+   */
+  startTimeMs = protoInt64.zero;
+
+  /**
+   * buckets that start before this time, 0 for no limit.
+   *
+   * @generated from field: int64 end_time_ms = 4;
+   */
+  endTimeMs = protoInt64.zero;
+
+  constructor(data?: PartialMessage<GetPlanMetricsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.GetPlanMetricsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "plan_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "bucket_size", kind: "enum", T: proto3.getEnumType(MetricsBucketSize) },
+    { no: 3, name: "start_time_ms", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 4, name: "end_time_ms", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetPlanMetricsRequest {
+    return new GetPlanMetricsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetPlanMetricsRequest {
+    return new GetPlanMetricsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetPlanMetricsRequest {
+    return new GetPlanMetricsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetPlanMetricsRequest | PlainMessage<GetPlanMetricsRequest> | undefined, b: GetPlanMetricsRequest | PlainMessage<GetPlanMetricsRequest> | undefined): boolean {
+    return proto3.util.equals(GetPlanMetricsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message v1.PlanMetrics
+ */
+export class PlanMetrics extends Message<PlanMetrics> {
+  /**
+   * @generated from field: string plan_id = 1;
+   */
+  planId = "";
+
+  /**
+   * @generated from field: v1.MetricsBucketSize bucket_size = 2;
+   */
+  bucketSize = MetricsBucketSize.BUCKET_SIZE_UNKNOWN;
+
+  /**
+   * buckets with at least one operation ordered by start time, empty periods are omitted.
+   *
+   * @generated from field: repeated v1.MetricsBucket buckets = 3;
+   */
+  buckets: MetricsBucket[] = [];
+
+  constructor(data?: PartialMessage<PlanMetrics>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.PlanMetrics";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "plan_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "bucket_size", kind: "enum", T: proto3.getEnumType(MetricsBucketSize) },
+    { no: 3, name: "buckets", kind: "message", T: MetricsBucket, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PlanMetrics {
+    return new PlanMetrics().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PlanMetrics {
+    return new PlanMetrics().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PlanMetrics {
+    return new PlanMetrics().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PlanMetrics | PlainMessage<PlanMetrics> | undefined, b: PlanMetrics | PlainMessage<PlanMetrics> | undefined): boolean {
+    return proto3.util.equals(PlanMetrics, a, b);
+  }
+}
+
+/**
+ * MetricsBucket summarizes the backup and stats operations of a plan that started within a period.
+ *
+ * @generated from message v1.MetricsBucket
+ */
+export class MetricsBucket extends Message<MetricsBucket> {
+  /**
+   * start of the period.
+   *
+   * @generated from field: int64 start_time_ms = 1;
+   */
+  startTimeMs = protoInt64.zero;
+
+  /**
+   * backups that completed, including those with warnings.
+   *
+   * @generated from field: int64 backups_succeeded = 2;
+   */
+  backupsSucceeded = protoInt64.zero;
+
+  /**
+   * @generated from field: int64 backups_failed = 3;
+   */
+  backupsFailed = protoInt64.zero;
+
+  /**
+   * summed wall clock duration of the backups.
+   *
+   * @generated from field: int64 total_duration_ms = 4;
+   */
+  totalDurationMs = protoInt64.zero;
+
+  /**
+   * summed data_added of the backup summaries.
+   *
+   * @generated from field: int64 data_added_bytes = 5;
+   */
+  dataAddedBytes = protoInt64.zero;
+
+  /**
+   * @generated from field: int64 files_new = 6;
+   */
+  filesNew = protoInt64.zero;
+
+  /**
+   * @generated from field: int64 files_changed = 7;
+   */
+  filesChanged = protoInt64.zero;
+
+  /**
+   * total size reported by the latest stats operation in the period, 0 if there was none.
+   *
+   * @generated from field: int64 repo_size_bytes = 8;
+   */
+  repoSizeBytes = protoInt64.zero;
+
+  /**
+   * start time of the stats operation repo_size_bytes was taken from.
+   *
+   * @generated from field: int64 repo_size_time_ms = 9;
+   */
+  repoSizeTimeMs = protoInt64.zero;
+
+  constructor(data?: PartialMessage<MetricsBucket>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.MetricsBucket";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "start_time_ms", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "backups_succeeded", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 3, name: "backups_failed", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 4, name: "total_duration_ms", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 5, name: "data_added_bytes", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 6, name: "files_new", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 7, name: "files_changed", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 8, name: "repo_size_bytes", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 9, name: "repo_size_time_ms", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MetricsBucket {
+    return new MetricsBucket().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MetricsBucket {
+    return new MetricsBucket().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MetricsBucket {
+    return new MetricsBucket().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: MetricsBucket | PlainMessage<MetricsBucket> | undefined, b: MetricsBucket | PlainMessage<MetricsBucket> | undefined): boolean {
+    return proto3.util.equals(MetricsBucket, a, b);
+  }
+}
+
+/**
+ * @generated from message v1.TestHookRequest
+ */
+export class TestHookRequest extends Message<TestHookRequest> {
+  /**
+   * the hook to run, it need not be saved in the config.
+   *
+   * @generated from field: v1.Hook hook = 1;
+   */
+  hook?: Hook;
+
+  /**
+   * the event to simulate, defaults to the hook's first condition.
+   *
+   * @generated from field: v1.Hook.Condition condition = 2;
+   */
+  condition = Hook_Condition.UNKNOWN;
+
+  /**
+   * plan whose recent operations provide the hook's variables, optional.
+   *
+   * @generated from field: string plan_id = 3;
+   */
+  planId = "";
+
+  /**
+   * repo the hook runs for, defaults to the plan's repo.
+   *
+   * @generated from field: string repo_id = 4;
+   */
+  repoId = "";
+
+  constructor(data?: PartialMessage<TestHookRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.TestHookRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "hook", kind: "message", T: Hook },
+    { no: 2, name: "condition", kind: "enum", T: proto3.getEnumType(Hook_Condition) },
+    { no: 3, name: "plan_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "repo_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TestHookRequest {
+    return new TestHookRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TestHookRequest {
+    return new TestHookRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TestHookRequest {
+    return new TestHookRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: TestHookRequest | PlainMessage<TestHookRequest> | undefined, b: TestHookRequest | PlainMessage<TestHookRequest> | undefined): boolean {
+    return proto3.util.equals(TestHookRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message v1.TestHookResponse
+ */
+export class TestHookResponse extends Message<TestHookResponse> {
+  /**
+   * the event that was simulated.
+   *
+   * @generated from field: v1.Hook.Condition condition = 1;
+   */
+  condition = Hook_Condition.UNKNOWN;
+
+  /**
+   * the hook's action rendered with the sample variables e.g. the command or the message.
+   *
+   * @generated from field: string rendered = 2;
+   */
+  rendered = "";
+
+  /**
+   * the hook ran and its action succeeded.
+   *
+   * @generated from field: bool success = 3;
+   */
+  success = false;
+
+  /**
+   * why the hook failed, including template errors.
+   *
+   * @generated from field: string error = 4;
+   */
+  error = "";
+
+  /**
+   * output of the action e.g. the command's output or the webhook's response.
+   *
+   * @generated from field: string output = 5;
+   */
+  output = "";
+
+  constructor(data?: PartialMessage<TestHookResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.TestHookResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "condition", kind: "enum", T: proto3.getEnumType(Hook_Condition) },
+    { no: 2, name: "rendered", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "success", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 4, name: "error", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "output", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TestHookResponse {
+    return new TestHookResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TestHookResponse {
+    return new TestHookResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TestHookResponse {
+    return new TestHookResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: TestHookResponse | PlainMessage<TestHookResponse> | undefined, b: TestHookResponse | PlainMessage<TestHookResponse> | undefined): boolean {
+    return proto3.util.equals(TestHookResponse, a, b);
+  }
+}
+
 /**
  * @generated from message v1.ForgetRequest
  */
@@ -154,6 +584,55 @@ export class ListSnapshotsRequest extends Message<ListSnapshotsRequest> {
 }
 
 /**
+ * @generated from message v1.GetOperationEventsRequest
+ */
+export class GetOperationEventsRequest extends Message<GetOperationEventsRequest> {
+  /**
+   * seq of the last event the client received, 0 to only receive new events.
+   *
+   * @generated from field: int64 since_seq = 1;
+   */
+  sinceSeq = protoInt64.zero;
+
+  /**
+   * if the client falls behind, drop the missed events and send EVENT_RESYNC_REQUIRED instead of closing the stream.
+   *
+   * @generated from field: bool resync_on_overflow = 2;
+   */
+  resyncOnOverflow = false;
+
+  constructor(data?: PartialMessage<GetOperationEventsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.GetOperationEventsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "since_seq", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "resync_on_overflow", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetOperationEventsRequest {
+    return new GetOperationEventsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetOperationEventsRequest {
+    return new GetOperationEventsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetOperationEventsRequest {
+    return new GetOperationEventsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetOperationEventsRequest | PlainMessage<GetOperationEventsRequest> | undefined, b: GetOperationEventsRequest | PlainMessage<GetOperationEventsRequest> | undefined): boolean {
+    return proto3.util.equals(GetOperationEventsRequest, a, b);
+  }
+}
+
+/**
+ * GetOperationsRequest selects operations, all set filters must match.
+ *
  * @generated from message v1.GetOperationsRequest
  */
 export class GetOperationsRequest extends Message<GetOperationsRequest> {
@@ -184,6 +663,62 @@ export class GetOperationsRequest extends Message<GetOperationsRequest> {
    */
   lastN = protoInt64.zero;
 
+  /**
+   * operations with any of the statuses.
+   *
+   * @generated from field: repeated v1.OperationStatus statuses = 6;
+   */
+  statuses: OperationStatus[] = [];
+
+  /**
+   * operations of any of the types.
+   *
+   * @generated from field: repeated v1.OperationType types = 7;
+   */
+  types: OperationType[] = [];
+
+  /**
+   * operations that started at or after this time.
+   *
+   * @generated from field: int64 start_time_ms = 8;
+   */
+  startTimeMs = protoInt64.zero;
+
+  /**
+   * operations that started before this time.
+   *
+   * @generated from field: int64 end_time_ms = 9;
+   */
+  endTimeMs = protoInt64.zero;
+
+  /**
+   * case insensitive substring of the operation's display_message.
+   *
+   * @generated from field: string search = 10;
+   */
+  search = "";
+
+  /**
+   * return operations after this id in the requested order, the next_cursor of a previous response.
+   *
+   * @generated from field: int64 cursor = 11;
+   */
+  cursor = protoInt64.zero;
+
+  /**
+   * maximum number of operations to return.
+   *
+   * @generated from field: int64 limit = 12;
+   */
+  limit = protoInt64.zero;
+
+  /**
+   * return operations ordered by id descending i.e. newest first.
+   *
+   * @generated from field: bool reverse = 13;
+   */
+  reverse = false;
+
   constructor(data?: PartialMessage<GetOperationsRequest>) {
     super();
     proto3.util.initPartial(data, this);
@@ -197,6 +732,14 @@ export class GetOperationsRequest extends Message<GetOperationsRequest> {
     { no: 4, name: "snapshot_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 5, name: "ids", kind: "scalar", T: 3 /* ScalarType.INT64 */, repeated: true },
     { no: 3, name: "last_n", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 6, name: "statuses", kind: "enum", T: proto3.getEnumType(OperationStatus), repeated: true },
+    { no: 7, name: "types", kind: "enum", T: proto3.getEnumType(OperationType), repeated: true },
+    { no: 8, name: "start_time_ms", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 9, name: "end_time_ms", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 10, name: "search", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 11, name: "cursor", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 12, name: "limit", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 13, name: "reverse", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetOperationsRequest {
@@ -216,6 +759,122 @@ export class GetOperationsRequest extends Message<GetOperationsRequest> {
   }
 }
 
+/**
+ * ExportOperationsRequest selects the operations to export, all set filters must match.
+ *
+ * @generated from message v1.ExportOperationsRequest
+ */
+export class ExportOperationsRequest extends Message<ExportOperationsRequest> {
+  /**
+   * @generated from field: string repo_id = 1;
+   */
+  repoId = "";
+
+  /**
+   * @generated from field: string plan_id = 2;
+   */
+  planId = "";
+
+  /**
+   * operations that started at or after this time.
+   *
+   * @generated from field: int64 start_time_ms = 3;
+   */
+  startTimeMs = protoInt64.zero;
+
+  /**
+   * operations that started before this time.
+   *
+   * @generated from field: int64 end_time_ms = 4;
+   */
+  endTimeMs = protoInt64.zero;
+
+  /**
+   * embed the logs referenced by the operations so they survive the move to another instance.
+   *
+   * @generated from field: bool include_logs = 5;
+   */
+  includeLogs = false;
+
+  constructor(data?: PartialMessage<ExportOperationsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.ExportOperationsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "repo_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "plan_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "start_time_ms", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 4, name: "end_time_ms", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 5, name: "include_logs", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ExportOperationsRequest {
+    return new ExportOperationsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ExportOperationsRequest {
+    return new ExportOperationsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ExportOperationsRequest {
+    return new ExportOperationsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ExportOperationsRequest | PlainMessage<ExportOperationsRequest> | undefined, b: ExportOperationsRequest | PlainMessage<ExportOperationsRequest> | undefined): boolean {
+    return proto3.util.equals(ExportOperationsRequest, a, b);
+  }
+}
+
+/**
+ * ImportOperationsRequest carries one exported operation of an import stream.
+ *
+ * @generated from message v1.ImportOperationsRequest
+ */
+export class ImportOperationsRequest extends Message<ImportOperationsRequest> {
+  /**
+   * @generated from field: v1.ExportedOperation operation = 1;
+   */
+  operation?: ExportedOperation;
+
+  /**
+   * store the logs embedded in the operation and re-link it to them, otherwise its logrefs are imported unchanged.
+   *
+   * @generated from field: bool relink_logs = 2;
+   */
+  relinkLogs = false;
+
+  constructor(data?: PartialMessage<ImportOperationsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.ImportOperationsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "operation", kind: "message", T: ExportedOperation },
+    { no: 2, name: "relink_logs", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ImportOperationsRequest {
+    return new ImportOperationsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ImportOperationsRequest {
+    return new ImportOperationsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ImportOperationsRequest {
+    return new ImportOperationsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ImportOperationsRequest | PlainMessage<ImportOperationsRequest> | undefined, b: ImportOperationsRequest | PlainMessage<ImportOperationsRequest> | undefined): boolean {
+    return proto3.util.equals(ImportOperationsRequest, a, b);
+  }
+}
+
 /**
  * @generated from message v1.RestoreSnapshotRequest
  */
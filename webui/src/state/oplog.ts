@@ -4,7 +4,10 @@ import {
   OperationEventType,
   OperationStatus,
 } from "../../gen/ts/v1/operations_pb";
-import { GetOperationsRequest } from "../../gen/ts/v1/service_pb";
+import {
+  GetOperationEventsRequest,
+  GetOperationsRequest,
+} from "../../gen/ts/v1/service_pb";
 import { BackupProgressEntry, ResticSnapshot } from "../../gen/ts/v1/restic_pb";
 import _ from "lodash";
 import { formatDuration, formatTime } from "../lib/formatting";
@@ -13,13 +16,46 @@ import { STATS_OPERATION_HISTORY } from "../constants";
 
 const subscribers: ((event: OperationEvent) => void)[] = [];
 
+// seq of the last event received, a reconnecting stream resumes after it. Until the stream first connects it is the lowest seq
+// returned with the operations fetched, so that the events between those fetches and the stream connecting are replayed.
+let lastSeq = BigInt(0);
+let streamStarted = false;
+
+const seedLastSeq = (seq: bigint) => {
+  if (!streamStarted && (lastSeq === BigInt(0) || seq < lastSeq)) {
+    lastSeq = seq;
+  }
+};
+
 // Start fetching and emitting operations.
 (async () => {
+  try {
+    const opList = await backrestService.getOperations(
+      new GetOperationsRequest({ lastN: BigInt(1) })
+    );
+    seedLastSeq(opList.seq);
+  } catch (e: any) {
+    console.error("failed to fetch the operation event seq: ", e);
+  }
+  streamStarted = true;
+
   while (true) {
     let nextConnWaitUntil = new Date().getTime() + 5000;
     try {
-      for await (const event of backrestService.getOperationEvents({})) {
+      const req = new GetOperationEventsRequest({
+        sinceSeq: lastSeq,
+        resyncOnOverflow: true,
+      });
+      for await (const event of backrestService.getOperationEvents(req)) {
         console.log("operation event", event);
+        if (!event.transient) {
+          lastSeq = event.seq;
+        }
+        if (event.type === OperationEventType.EVENT_RESYNC_REQUIRED) {
+          // the missed events are gone, reload so that every view refetches its operations.
+          window.location.reload();
+          return;
+        }
         subscribers.forEach((subscriber) => subscriber(event));
       }
     } catch (e: any) {
@@ -35,6 +71,7 @@ export const getOperations = async (
   req: GetOperationsRequest
 ): Promise<Operation[]> => {
   const opList = await backrestService.getOperations(req);
+  seedLastSeq(opList.seq);
   return opList.operations || [];
 };
 
@@ -421,7 +421,7 @@ export const AddRepoModal = ({
             </Form.Item>
           </Form.Item>
 
-          <Form.Item label={<Tooltip title={"Auto-unlock will remove lockfiles at the start of forget and prune operations. "
+          <Form.Item label={<Tooltip title={"Auto-unlock will remove stale lockfiles and retry when a backup, forget or prune operation finds the repo locked. "
             + "This is potentially unsafe if the repo is shared by multiple client devices. Opt-in (and disabled) by default."}>
             Auto Unlock
           </Tooltip>} name="autoUnlock" valuePropName="checked">